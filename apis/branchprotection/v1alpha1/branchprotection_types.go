@@ -0,0 +1,166 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StatusCheck is a status check that must pass before merging.
+type StatusCheck struct {
+	// Context: the name of the status check.
+	Context string `json:"context"`
+
+	// AppId: the id of the GitHub App that must provide the check;
+	// when omitted any app that recently provided the check is allowed.
+	// +optional
+	AppId *int64 `json:"appId,omitempty"`
+}
+
+// RequiredStatusChecks holds the status checks required before merging.
+type RequiredStatusChecks struct {
+	// Strict: whether branches must be up to date before merging (default: false).
+	// +optional
+	Strict *bool `json:"strict,omitempty"`
+
+	// Checks: the status checks that must pass.
+	// +optional
+	Checks []StatusCheck `json:"checks,omitempty"`
+}
+
+// RequiredPullRequestReviews holds the pull request reviews required before merging.
+type RequiredPullRequestReviews struct {
+	// RequiredApprovingReviewCount: the number of approvals required (default: 1).
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=6
+	// +optional
+	RequiredApprovingReviewCount *int `json:"requiredApprovingReviewCount,omitempty"`
+
+	// RequireCodeOwnerReviews: whether the code owners must approve (default: false).
+	// +optional
+	RequireCodeOwnerReviews *bool `json:"requireCodeOwnerReviews,omitempty"`
+
+	// DismissStaleReviews: whether new commits dismiss the approvals (default: false).
+	// +optional
+	DismissStaleReviews *bool `json:"dismissStaleReviews,omitempty"`
+
+	// RequireLastPushApproval: whether the most recent push must be approved
+	// by someone other than the person who pushed it (default: false).
+	// +optional
+	RequireLastPushApproval *bool `json:"requireLastPushApproval,omitempty"`
+}
+
+// BranchRestrictions holds who can push to the branch; only for organization repositories.
+type BranchRestrictions struct {
+	// Users: the logins of the users allowed to push.
+	// +optional
+	Users []string `json:"users,omitempty"`
+
+	// Teams: the slugs of the teams allowed to push.
+	// +optional
+	Teams []string `json:"teams,omitempty"`
+
+	// Apps: the slugs of the GitHub Apps allowed to push.
+	// +optional
+	Apps []string `json:"apps,omitempty"`
+}
+
+// BranchProtectionRules holds the protection rules of a branch; rules
+// not set are disabled, since the protection is replaced as a whole.
+type BranchProtectionRules struct {
+	// RequiredStatusChecks: the status checks required before merging.
+	// +optional
+	RequiredStatusChecks *RequiredStatusChecks `json:"requiredStatusChecks,omitempty"`
+
+	// RequiredPullRequestReviews: the pull request reviews required before merging.
+	// +optional
+	RequiredPullRequestReviews *RequiredPullRequestReviews `json:"requiredPullRequestReviews,omitempty"`
+
+	// EnforceAdmins: whether the rules apply to the administrators too.
+	// +optional
+	EnforceAdmins *bool `json:"enforceAdmins,omitempty"`
+
+	// Restrictions: who can push to the branch.
+	// +optional
+	Restrictions *BranchRestrictions `json:"restrictions,omitempty"`
+
+	// RequiredLinearHistory: whether merge commits are prevented.
+	// +optional
+	RequiredLinearHistory *bool `json:"requiredLinearHistory,omitempty"`
+
+	// AllowForcePushes: whether force pushes are allowed.
+	// +optional
+	AllowForcePushes *bool `json:"allowForcePushes,omitempty"`
+
+	// AllowDeletions: whether the branch can be deleted.
+	// +optional
+	AllowDeletions *bool `json:"allowDeletions,omitempty"`
+
+	// RequiredConversationResolution: whether all the conversations
+	// must be resolved before merging.
+	// +optional
+	RequiredConversationResolution *bool `json:"requiredConversationResolution,omitempty"`
+
+	// RequiredSignatures: whether the commits must be signed.
+	// +optional
+	RequiredSignatures *bool `json:"requiredSignatures,omitempty"`
+}
+
+type BranchProtectionParams struct {
+	// Org: the repository owner.
+	// +immutable
+	Org string `json:"org"`
+
+	// Repo: the repository name.
+	// +immutable
+	Repo string `json:"repo"`
+
+	// Branch: the name of the protected branch.
+	// +immutable
+	Branch string `json:"branch"`
+
+	BranchProtectionRules `json:",inline"`
+}
+
+type BranchProtectionObservation struct {
+	// Url: the API URL of the branch protection.
+	Url *string `json:"url,omitempty"`
+
+	BranchProtectionRules `json:",inline"`
+}
+
+// A BranchProtectionSpec defines the desired state of a BranchProtection.
+type BranchProtectionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BranchProtectionParams `json:"forProvider"`
+}
+
+// A BranchProtectionStatus represents the observed state of a BranchProtection.
+type BranchProtectionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BranchProtectionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BranchProtection is a managed resource that represents the protection rules of a GitHub repository branch
+// +kubebuilder:printcolumn:name="BRANCH",type="string",JSONPath=".spec.forProvider.branch"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,krateo,github}
+type BranchProtection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BranchProtectionSpec   `json:"spec"`
+	Status BranchProtectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BranchProtectionList contains a list of BranchProtection.
+type BranchProtectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BranchProtection `json:"items"`
+}
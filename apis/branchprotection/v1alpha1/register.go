@@ -0,0 +1,34 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "github.krateo.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// BranchProtection type metadata.
+var (
+	BranchProtectionKind             = reflect.TypeOf(BranchProtection{}).Name()
+	BranchProtectionGroupKind        = schema.GroupKind{Group: Group, Kind: BranchProtectionKind}.String()
+	BranchProtectionKindAPIVersion   = BranchProtectionKind + "." + SchemeGroupVersion.String()
+	BranchProtectionGroupVersionKind = SchemeGroupVersion.WithKind(BranchProtectionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BranchProtection{}, &BranchProtectionList{})
+}
@@ -0,0 +1,328 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2022 Kiratech S.p.A.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtection) DeepCopyInto(out *BranchProtection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtection.
+func (in *BranchProtection) DeepCopy() *BranchProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BranchProtection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionList) DeepCopyInto(out *BranchProtectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BranchProtection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionList.
+func (in *BranchProtectionList) DeepCopy() *BranchProtectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BranchProtectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionObservation) DeepCopyInto(out *BranchProtectionObservation) {
+	*out = *in
+	if in.Url != nil {
+		in, out := &in.Url, &out.Url
+		*out = new(string)
+		**out = **in
+	}
+	in.BranchProtectionRules.DeepCopyInto(&out.BranchProtectionRules)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionObservation.
+func (in *BranchProtectionObservation) DeepCopy() *BranchProtectionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionParams) DeepCopyInto(out *BranchProtectionParams) {
+	*out = *in
+	in.BranchProtectionRules.DeepCopyInto(&out.BranchProtectionRules)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionParams.
+func (in *BranchProtectionParams) DeepCopy() *BranchProtectionParams {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionRules) DeepCopyInto(out *BranchProtectionRules) {
+	*out = *in
+	if in.RequiredStatusChecks != nil {
+		in, out := &in.RequiredStatusChecks, &out.RequiredStatusChecks
+		*out = new(RequiredStatusChecks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequiredPullRequestReviews != nil {
+		in, out := &in.RequiredPullRequestReviews, &out.RequiredPullRequestReviews
+		*out = new(RequiredPullRequestReviews)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnforceAdmins != nil {
+		in, out := &in.EnforceAdmins, &out.EnforceAdmins
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Restrictions != nil {
+		in, out := &in.Restrictions, &out.Restrictions
+		*out = new(BranchRestrictions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequiredLinearHistory != nil {
+		in, out := &in.RequiredLinearHistory, &out.RequiredLinearHistory
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowForcePushes != nil {
+		in, out := &in.AllowForcePushes, &out.AllowForcePushes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowDeletions != nil {
+		in, out := &in.AllowDeletions, &out.AllowDeletions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequiredConversationResolution != nil {
+		in, out := &in.RequiredConversationResolution, &out.RequiredConversationResolution
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequiredSignatures != nil {
+		in, out := &in.RequiredSignatures, &out.RequiredSignatures
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionRules.
+func (in *BranchProtectionRules) DeepCopy() *BranchProtectionRules {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionSpec) DeepCopyInto(out *BranchProtectionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionSpec.
+func (in *BranchProtectionSpec) DeepCopy() *BranchProtectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionStatus) DeepCopyInto(out *BranchProtectionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionStatus.
+func (in *BranchProtectionStatus) DeepCopy() *BranchProtectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchRestrictions) DeepCopyInto(out *BranchRestrictions) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Apps != nil {
+		in, out := &in.Apps, &out.Apps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchRestrictions.
+func (in *BranchRestrictions) DeepCopy() *BranchRestrictions {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchRestrictions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequiredPullRequestReviews) DeepCopyInto(out *RequiredPullRequestReviews) {
+	*out = *in
+	if in.RequiredApprovingReviewCount != nil {
+		in, out := &in.RequiredApprovingReviewCount, &out.RequiredApprovingReviewCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.RequireCodeOwnerReviews != nil {
+		in, out := &in.RequireCodeOwnerReviews, &out.RequireCodeOwnerReviews
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DismissStaleReviews != nil {
+		in, out := &in.DismissStaleReviews, &out.DismissStaleReviews
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireLastPushApproval != nil {
+		in, out := &in.RequireLastPushApproval, &out.RequireLastPushApproval
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequiredPullRequestReviews.
+func (in *RequiredPullRequestReviews) DeepCopy() *RequiredPullRequestReviews {
+	if in == nil {
+		return nil
+	}
+	out := new(RequiredPullRequestReviews)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequiredStatusChecks) DeepCopyInto(out *RequiredStatusChecks) {
+	*out = *in
+	if in.Strict != nil {
+		in, out := &in.Strict, &out.Strict
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]StatusCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequiredStatusChecks.
+func (in *RequiredStatusChecks) DeepCopy() *RequiredStatusChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(RequiredStatusChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusCheck) DeepCopyInto(out *StatusCheck) {
+	*out = *in
+	if in.AppId != nil {
+		in, out := &in.AppId, &out.AppId
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusCheck.
+func (in *StatusCheck) DeepCopy() *StatusCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusCheck)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,84 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type BranchParams struct {
+	// Org: the repository owner.
+	// +immutable
+	Org string `json:"org"`
+
+	// Repo: the repository name.
+	// +immutable
+	Repo string `json:"repo"`
+
+	// Name: the branch name (i.e. develop or release/1.0).
+	// +immutable
+	Name string `json:"name"`
+
+	// SourceBranch: the branch the new branch starts from; when
+	// neither sourceBranch nor sourceSha is set the default branch is used.
+	// +immutable
+	// +optional
+	SourceBranch *string `json:"sourceBranch,omitempty"`
+
+	// SourceSha: the commit the new branch starts from; takes precedence over sourceBranch.
+	// +immutable
+	// +optional
+	SourceSha *string `json:"sourceSha,omitempty"`
+
+	// Default: whether the branch is the repository default branch (default: false);
+	// the default branch is not deleted with the resource.
+	// +optional
+	Default *bool `json:"default,omitempty"`
+}
+
+type BranchObservation struct {
+	// Ref: the fully qualified reference (i.e. refs/heads/develop).
+	Ref *string `json:"ref,omitempty"`
+
+	// Sha: the commit the branch points to.
+	Sha *string `json:"sha,omitempty"`
+
+	// Default: whether the branch is the repository default branch.
+	Default *bool `json:"default,omitempty"`
+}
+
+// A BranchSpec defines the desired state of a Branch.
+type BranchSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BranchParams `json:"forProvider"`
+}
+
+// A BranchStatus represents the observed state of a Branch.
+type BranchStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BranchObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Branch is a managed resource that represents a GitHub repository branch
+// +kubebuilder:printcolumn:name="SHA",type="string",JSONPath=".status.atProvider.sha"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,krateo,github}
+type Branch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BranchSpec   `json:"spec"`
+	Status BranchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BranchList contains a list of Branch.
+type BranchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Branch `json:"items"`
+}
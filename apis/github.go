@@ -3,7 +3,11 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	branchprotectionv1alpha1 "github.com/krateoplatformops/provider-github/apis/branchprotection/v1alpha1"
+	gitv1alpha1 "github.com/krateoplatformops/provider-github/apis/git/v1alpha1"
+	orgv1alpha1 "github.com/krateoplatformops/provider-github/apis/org/v1alpha1"
 	repov1alpha1 "github.com/krateoplatformops/provider-github/apis/repo/v1alpha1"
+	rulesetv1alpha1 "github.com/krateoplatformops/provider-github/apis/ruleset/v1alpha1"
 	githubv1alpha1 "github.com/krateoplatformops/provider-github/apis/v1alpha1"
 )
 
@@ -12,6 +16,10 @@ func init() {
 	AddToSchemes = append(AddToSchemes,
 		githubv1alpha1.SchemeBuilder.AddToScheme,
 		repov1alpha1.SchemeBuilder.AddToScheme,
+		orgv1alpha1.SchemeBuilder.AddToScheme,
+		branchprotectionv1alpha1.SchemeBuilder.AddToScheme,
+		rulesetv1alpha1.SchemeBuilder.AddToScheme,
+		gitv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -0,0 +1,99 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type OrgCustomPropertySchemaParams struct {
+	// Org: the organization name.
+	// +immutable
+	Org string `json:"org"`
+
+	// Name: the custom property name.
+	// +immutable
+	Name string `json:"name"`
+
+	// ValueType: the type of the value of the property.
+	// +kubebuilder:validation:Enum=string;single_select;multi_select;true_false
+	ValueType string `json:"valueType"`
+
+	// Required: whether the property is required (default: false).
+	// +optional
+	Required *bool `json:"required,omitempty"`
+
+	// DefaultValue: the default value of the property; required properties must have one.
+	// Use defaultValues for multi_select properties.
+	// +optional
+	DefaultValue *string `json:"defaultValue,omitempty"`
+
+	// DefaultValues: the default values of multi_select properties.
+	// +optional
+	DefaultValues []string `json:"defaultValues,omitempty"`
+
+	// Description: a short description of the property.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// AllowedValues: the values allowed for single_select and multi_select properties.
+	// +optional
+	AllowedValues []string `json:"allowedValues,omitempty"`
+
+	// ValuesEditableBy: who can edit the values of the property.
+	// +kubebuilder:validation:Enum=org_actors;org_and_repo_actors
+	// +optional
+	ValuesEditableBy *string `json:"valuesEditableBy,omitempty"`
+}
+
+type OrgCustomPropertySchemaObservation struct {
+	// ValueType: the type of the value of the property.
+	ValueType *string `json:"valueType,omitempty"`
+
+	// Required: whether the property is required.
+	Required *bool `json:"required,omitempty"`
+
+	// DefaultValue: the default value of the property.
+	DefaultValue *string `json:"defaultValue,omitempty"`
+
+	// DefaultValues: the default values of multi_select properties.
+	DefaultValues []string `json:"defaultValues,omitempty"`
+
+	// AllowedValues: the values allowed for the property.
+	AllowedValues []string `json:"allowedValues,omitempty"`
+}
+
+// A OrgCustomPropertySchemaSpec defines the desired state of a OrgCustomPropertySchema.
+type OrgCustomPropertySchemaSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrgCustomPropertySchemaParams `json:"forProvider"`
+}
+
+// A OrgCustomPropertySchemaStatus represents the observed state of a OrgCustomPropertySchema.
+type OrgCustomPropertySchemaStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrgCustomPropertySchemaObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A OrgCustomPropertySchema is a managed resource that represents a GitHub organization custom property definition
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,krateo,github}
+type OrgCustomPropertySchema struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrgCustomPropertySchemaSpec   `json:"spec"`
+	Status OrgCustomPropertySchemaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrgCustomPropertySchemaList contains a list of OrgCustomPropertySchema.
+type OrgCustomPropertySchemaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrgCustomPropertySchema `json:"items"`
+}
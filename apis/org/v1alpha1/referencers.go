@@ -0,0 +1,97 @@
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	repov1alpha1 "github.com/krateoplatformops/provider-github/apis/repo/v1alpha1"
+)
+
+// RepoFullName extracts the owner/repo full name of a Repo from its external-name,
+// falling back to the organization of the Repo when the external-name is the bare name.
+func RepoFullName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		en := meta.GetExternalName(mg)
+		if en == "" || strings.Contains(en, "/") {
+			return en
+		}
+		if r, ok := mg.(*repov1alpha1.Repo); ok && r.Spec.ForProvider.Org != "" {
+			return r.Spec.ForProvider.Org + "/" + en
+		}
+		return en
+	}
+}
+
+// TeamSlug extracts the slug of a Team, which changes when the team is renamed.
+func TeamSlug() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if t, ok := mg.(*Team); ok && t.Status.AtProvider.Slug != nil {
+			return *t.Status.AtProvider.Slug
+		}
+		return meta.GetExternalName(mg)
+	}
+}
+
+// ResolveReferences of this Team.
+func (mg *Team) ResolveReferences(ctx context.Context, c client.Reader) error {
+	// An empty parentTeamSlug removes the parent, so it is kept as is.
+	if mg.Spec.ForProvider.ParentTeamSlugRef == nil && mg.Spec.ForProvider.ParentTeamSlugSelector == nil {
+		return nil
+	}
+
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ParentTeamSlug),
+		Reference:    mg.Spec.ForProvider.ParentTeamSlugRef,
+		Selector:     mg.Spec.ForProvider.ParentTeamSlugSelector,
+		To:           reference.To{Managed: &Team{}, List: &TeamList{}},
+		Extract:      TeamSlug(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parentTeamSlug")
+	}
+	mg.Spec.ForProvider.ParentTeamSlug = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ParentTeamSlugRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TeamRepository.
+func (mg *TeamRepository) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TeamSlug),
+		Reference:    mg.Spec.ForProvider.TeamSlugRef,
+		Selector:     mg.Spec.ForProvider.TeamSlugSelector,
+		To:           reference.To{Managed: &Team{}, List: &TeamList{}},
+		Extract:      TeamSlug(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.teamSlug")
+	}
+	mg.Spec.ForProvider.TeamSlug = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TeamSlugRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Repo),
+		Reference:    mg.Spec.ForProvider.RepoRef,
+		Selector:     mg.Spec.ForProvider.RepoSelector,
+		To:           reference.To{Managed: &repov1alpha1.Repo{}, List: &repov1alpha1.RepoList{}},
+		Extract:      RepoFullName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repo")
+	}
+	mg.Spec.ForProvider.Repo = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RepoRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "github.krateo.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// OrgCustomPropertySchema type metadata.
+var (
+	OrgCustomPropertySchemaKind             = reflect.TypeOf(OrgCustomPropertySchema{}).Name()
+	OrgCustomPropertySchemaGroupKind        = schema.GroupKind{Group: Group, Kind: OrgCustomPropertySchemaKind}.String()
+	OrgCustomPropertySchemaKindAPIVersion   = OrgCustomPropertySchemaKind + "." + SchemeGroupVersion.String()
+	OrgCustomPropertySchemaGroupVersionKind = SchemeGroupVersion.WithKind(OrgCustomPropertySchemaKind)
+)
+
+// Team type metadata.
+var (
+	TeamKind             = reflect.TypeOf(Team{}).Name()
+	TeamGroupKind        = schema.GroupKind{Group: Group, Kind: TeamKind}.String()
+	TeamKindAPIVersion   = TeamKind + "." + SchemeGroupVersion.String()
+	TeamGroupVersionKind = SchemeGroupVersion.WithKind(TeamKind)
+)
+
+// TeamRepository type metadata.
+var (
+	TeamRepositoryKind             = reflect.TypeOf(TeamRepository{}).Name()
+	TeamRepositoryGroupKind        = schema.GroupKind{Group: Group, Kind: TeamRepositoryKind}.String()
+	TeamRepositoryKindAPIVersion   = TeamRepositoryKind + "." + SchemeGroupVersion.String()
+	TeamRepositoryGroupVersionKind = SchemeGroupVersion.WithKind(TeamRepositoryKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrgCustomPropertySchema{}, &OrgCustomPropertySchemaList{})
+	SchemeBuilder.Register(&Team{}, &TeamList{})
+	SchemeBuilder.Register(&TeamRepository{}, &TeamRepositoryList{})
+}
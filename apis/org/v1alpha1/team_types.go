@@ -0,0 +1,117 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type TeamParams struct {
+	// Org: the organization name.
+	// +immutable
+	Org string `json:"org"`
+
+	// Name: the team name; renaming the team changes its slug.
+	Name string `json:"name"`
+
+	// Description: a short description of the team.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Privacy: the visibility of the team; nested teams must be closed (default: secret, closed for nested teams).
+	// +kubebuilder:validation:Enum=secret;closed
+	// +optional
+	Privacy *string `json:"privacy,omitempty"`
+
+	// NotificationSetting: whether the team members are notified when the team is mentioned.
+	// +kubebuilder:validation:Enum=notifications_enabled;notifications_disabled
+	// +optional
+	NotificationSetting *string `json:"notificationSetting,omitempty"`
+
+	// ParentTeamSlug: the slug of the parent team; set it empty to remove the parent.
+	// +optional
+	ParentTeamSlug *string `json:"parentTeamSlug,omitempty"`
+
+	// ParentTeamSlugRef: a reference to the Team used to set parentTeamSlug.
+	// +optional
+	ParentTeamSlugRef *xpv1.Reference `json:"parentTeamSlugRef,omitempty"`
+
+	// ParentTeamSlugSelector: selects a reference to the Team used to set parentTeamSlug.
+	// +optional
+	ParentTeamSlugSelector *xpv1.Selector `json:"parentTeamSlugSelector,omitempty"`
+
+	// Maintainers: the logins of the team maintainers; when maintainers or
+	// members are set, the users not listed are removed from the team, except
+	// the members of the child teams, which GitHub lists as team members too.
+	// +optional
+	Maintainers []string `json:"maintainers,omitempty"`
+
+	// Members: the logins of the team members.
+	// +optional
+	Members []string `json:"members,omitempty"`
+}
+
+type TeamObservation struct {
+	// Id: the team unique identifier.
+	Id *int64 `json:"id,omitempty"`
+
+	// NodeId: the team GraphQL node identifier.
+	NodeId *string `json:"nodeId,omitempty"`
+
+	// Slug: the team slug.
+	Slug *string `json:"slug,omitempty"`
+
+	// Url: the team web URL.
+	Url *string `json:"url,omitempty"`
+
+	// Privacy: the visibility of the team.
+	Privacy *string `json:"privacy,omitempty"`
+
+	// ParentTeamSlug: the slug of the parent team.
+	ParentTeamSlug *string `json:"parentTeamSlug,omitempty"`
+
+	// Maintainers: the logins of the team maintainers, including the invited ones.
+	Maintainers []string `json:"maintainers,omitempty"`
+
+	// Members: the logins of the team members, including the invited ones.
+	Members []string `json:"members,omitempty"`
+
+	// Invited: the logins of the users invited to the team who have not accepted yet.
+	Invited []string `json:"invited,omitempty"`
+}
+
+// A TeamSpec defines the desired state of a Team.
+type TeamSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TeamParams `json:"forProvider"`
+}
+
+// A TeamStatus represents the observed state of a Team.
+type TeamStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TeamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Team is a managed resource that represents a GitHub organization team
+// +kubebuilder:printcolumn:name="SLUG",type="string",JSONPath=".status.atProvider.slug"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,krateo,github}
+type Team struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamSpec   `json:"spec"`
+	Status TeamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamList contains a list of Team.
+type TeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Team `json:"items"`
+}
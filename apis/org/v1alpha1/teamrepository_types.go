@@ -0,0 +1,90 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type TeamRepositoryParams struct {
+	// Org: the organization owning the team and the repository.
+	// +immutable
+	Org string `json:"org"`
+
+	// TeamSlug: the slug of the team.
+	// +immutable
+	// +optional
+	TeamSlug *string `json:"teamSlug,omitempty"`
+
+	// TeamSlugRef: a reference to the Team used to set teamSlug.
+	// +optional
+	TeamSlugRef *xpv1.Reference `json:"teamSlugRef,omitempty"`
+
+	// TeamSlugSelector: selects a reference to the Team used to set teamSlug.
+	// +optional
+	TeamSlugSelector *xpv1.Selector `json:"teamSlugSelector,omitempty"`
+
+	// Repo: the name of the repository, or owner/repo for repositories
+	// not owned by the organization (i.e. forks); set to owner/repo by repoRef.
+	// +immutable
+	// +optional
+	Repo *string `json:"repo,omitempty"`
+
+	// RepoRef: a reference to the Repo used to set repo.
+	// +optional
+	RepoRef *xpv1.Reference `json:"repoRef,omitempty"`
+
+	// RepoSelector: selects a reference to the Repo used to set repo.
+	// +optional
+	RepoSelector *xpv1.Selector `json:"repoSelector,omitempty"`
+
+	// Permission: the permission granted to the team: pull, triage,
+	// push, maintain, admin or the name of a custom repository role.
+	// +kubebuilder:validation:MinLength=1
+	Permission string `json:"permission"`
+}
+
+type TeamRepositoryObservation struct {
+	// Permission: the role of the team on the repository.
+	Permission *string `json:"permission,omitempty"`
+}
+
+// A TeamRepositorySpec defines the desired state of a TeamRepository.
+type TeamRepositorySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TeamRepositoryParams `json:"forProvider"`
+}
+
+// A TeamRepositoryStatus represents the observed state of a TeamRepository.
+type TeamRepositoryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TeamRepositoryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TeamRepository is a managed resource that represents the access of a GitHub team to a repository.
+// GitHub reports the access inherited from a parent team as well: a TeamRepository grants the access
+// to the team directly even if it is inherited, and deleting it leaves the inherited access.
+// +kubebuilder:printcolumn:name="TEAM",type="string",JSONPath=".spec.forProvider.teamSlug"
+// +kubebuilder:printcolumn:name="REPO",type="string",JSONPath=".spec.forProvider.repo"
+// +kubebuilder:printcolumn:name="PERMISSION",type="string",JSONPath=".status.atProvider.permission"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,krateo,github}
+type TeamRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamRepositorySpec   `json:"spec"`
+	Status TeamRepositoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamRepositoryList contains a list of TeamRepository.
+type TeamRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TeamRepository `json:"items"`
+}
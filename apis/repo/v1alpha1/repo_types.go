@@ -5,30 +5,313 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// OnDeletePolicy specifies what happens to the repository when the resource is deleted.
+type OnDeletePolicy string
+
+// Repository deletion policies.
+const (
+	// OnDeleteDelete permanently deletes the repository.
+	OnDeleteDelete OnDeletePolicy = "delete"
+	// OnDeleteArchive archives the repository.
+	OnDeleteArchive OnDeletePolicy = "archive"
+	// OnDeleteRenameAndArchive renames the repository with an
+	// -archived-<deletion timestamp> suffix, then archives it.
+	OnDeleteRenameAndArchive OnDeletePolicy = "rename-and-archive"
+	// OnDeleteTransferToGraveyardOrg transfers the repository to the graveyard organization.
+	OnDeleteTransferToGraveyardOrg OnDeletePolicy = "transfer-to-graveyard-org"
+)
+
+// RepoTemplate identifies the template repository to create the repository from.
+type RepoTemplate struct {
+	// Owner: the template repository owner.
+	Owner string `json:"owner"`
+
+	// Name: the template repository name.
+	Name string `json:"name"`
+
+	// IncludeAllBranches: whether to include the files of all the branches
+	// of the template, not just the default branch (default: false).
+	// +optional
+	IncludeAllBranches *bool `json:"includeAllBranches,omitempty"`
+}
+
+// RepoFork identifies the repository to fork.
+type RepoFork struct {
+	// Owner: the forked repository owner.
+	Owner string `json:"owner"`
+
+	// Name: the forked repository name.
+	Name string `json:"name"`
+
+	// DefaultBranchOnly: whether to fork the default branch only (default: false).
+	// +optional
+	DefaultBranchOnly *bool `json:"defaultBranchOnly,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key whose value will be used.
+	Key string `json:"key"`
+}
+
+// RepoInitialFile is a file committed to the repository once it is created.
+type RepoInitialFile struct {
+	// Path: the file path in the repository (i.e. .github/CODEOWNERS).
+	Path string `json:"path"`
+
+	// ConfigMapKeyRef: the ConfigMap key holding the file content.
+	ConfigMapKeyRef ConfigMapKeySelector `json:"configMapKeyRef"`
+
+	// Message: the commit message (default: "Add <path>").
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// RepoSecurityAndAnalysis holds the security and analysis features of a repository.
+type RepoSecurityAndAnalysis struct {
+	// AdvancedSecurity: whether GitHub Advanced Security is enabled.
+	// +optional
+	AdvancedSecurity *bool `json:"advancedSecurity,omitempty"`
+
+	// SecretScanning: whether secret scanning is enabled.
+	// +optional
+	SecretScanning *bool `json:"secretScanning,omitempty"`
+
+	// SecretScanningPushProtection: whether pushes containing secrets are blocked.
+	// +optional
+	SecretScanningPushProtection *bool `json:"secretScanningPushProtection,omitempty"`
+
+	// DependabotSecurityUpdates: whether Dependabot opens pull requests
+	// to fix vulnerable dependencies; requires vulnerability alerts.
+	// +optional
+	DependabotSecurityUpdates *bool `json:"dependabotSecurityUpdates,omitempty"`
+
+	// VulnerabilityAlerts: whether Dependabot alerts are enabled.
+	// +optional
+	VulnerabilityAlerts *bool `json:"vulnerabilityAlerts,omitempty"`
+}
+
 type RepoParams struct {
-	// Org: the organization name.
-	// +immutable
+	// Org: the organization name; changing it transfers the repository.
 	Org string `json:"org"`
 
-	// Name: the name of the repository.
-	// +immutable
+	// TransferTeamIds: the ids of the teams given access to
+	// the repository when it is transferred to an organization.
+	// +optional
+	TransferTeamIds []int64 `json:"transferTeamIds,omitempty"`
+
+	// Name: the name of the repository; changing it renames the repository.
 	Name string `json:"name"`
 
-	// Private: whether the repository is private (default: true).
+	// Private: whether the repository is private; repositories are created
+	// private when unset, and left untouched afterwards. Ignored when visibility is set.
 	// +optional
-	Private bool `json:"private,omitempty"`
+	Private *bool `json:"private,omitempty"`
 
 	// Initialize: whether the repository must be initialized (default: true).
+	// Ignored when fromTemplate or fromFork is set.
 	// +optional
 	Initialize *bool `json:"initialize,omitempty"`
+
+	// FromTemplate: create the repository from a template repository.
+	// +immutable
+	// +optional
+	FromTemplate *RepoTemplate `json:"fromTemplate,omitempty"`
+
+	// FromFork: create the repository as a fork of another repository;
+	// org is the organization (or user) the fork is created into.
+	// +immutable
+	// +optional
+	FromFork *RepoFork `json:"fromFork,omitempty"`
+
+	// GitignoreTemplate: the .gitignore template to apply on creation (i.e. Go).
+	// +immutable
+	// +optional
+	GitignoreTemplate *string `json:"gitignoreTemplate,omitempty"`
+
+	// LicenseTemplate: the keyword of the license to apply on creation (i.e. mit).
+	// +immutable
+	// +optional
+	LicenseTemplate *string `json:"licenseTemplate,omitempty"`
+
+	// InitialFiles: additional files committed to the repository once created
+	// (i.e. CODEOWNERS or a starter workflow); existing files are left untouched.
+	// +immutable
+	// +optional
+	InitialFiles []RepoInitialFile `json:"initialFiles,omitempty"`
+
+	// Description: a short description of the repository.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Homepage: a URL with more information about the repository.
+	// +optional
+	Homepage *string `json:"homepage,omitempty"`
+
+	// Visibility: the repository visibility; internal is available
+	// only for organizations of enterprise accounts.
+	// +kubebuilder:validation:Enum=public;private;internal
+	// +optional
+	Visibility *string `json:"visibility,omitempty"`
+
+	// HasIssues: whether issues are enabled.
+	// +optional
+	HasIssues *bool `json:"hasIssues,omitempty"`
+
+	// HasWiki: whether the wiki is enabled.
+	// +optional
+	HasWiki *bool `json:"hasWiki,omitempty"`
+
+	// HasProjects: whether projects are enabled.
+	// +optional
+	HasProjects *bool `json:"hasProjects,omitempty"`
+
+	// HasDiscussions: whether discussions are enabled.
+	// +optional
+	HasDiscussions *bool `json:"hasDiscussions,omitempty"`
+
+	// AllowMergeCommit: whether to allow merge commits for pull requests.
+	// +optional
+	AllowMergeCommit *bool `json:"allowMergeCommit,omitempty"`
+
+	// AllowSquashMerge: whether to allow squash merges for pull requests.
+	// +optional
+	AllowSquashMerge *bool `json:"allowSquashMerge,omitempty"`
+
+	// AllowRebaseMerge: whether to allow rebase merges for pull requests.
+	// +optional
+	AllowRebaseMerge *bool `json:"allowRebaseMerge,omitempty"`
+
+	// SquashMergeCommitTitle: the default value for a squash merge commit title.
+	// +kubebuilder:validation:Enum=PR_TITLE;COMMIT_OR_PR_TITLE
+	// +optional
+	SquashMergeCommitTitle *string `json:"squashMergeCommitTitle,omitempty"`
+
+	// SquashMergeCommitMessage: the default value for a squash merge commit message.
+	// +kubebuilder:validation:Enum=PR_BODY;COMMIT_MESSAGES;BLANK
+	// +optional
+	SquashMergeCommitMessage *string `json:"squashMergeCommitMessage,omitempty"`
+
+	// DeleteBranchOnMerge: whether to delete head branches when pull requests are merged.
+	// +optional
+	DeleteBranchOnMerge *bool `json:"deleteBranchOnMerge,omitempty"`
+
+	// AllowAutoMerge: whether to allow auto-merge on pull requests.
+	// +optional
+	AllowAutoMerge *bool `json:"allowAutoMerge,omitempty"`
+
+	// AllowUpdateBranch: whether to always suggest updating pull request branches.
+	// +optional
+	AllowUpdateBranch *bool `json:"allowUpdateBranch,omitempty"`
+
+	// WebCommitSignoffRequired: whether contributors must sign off on web-based commits.
+	// +optional
+	WebCommitSignoffRequired *bool `json:"webCommitSignoffRequired,omitempty"`
+
+	// IsTemplate: whether the repository is available as a template.
+	// +optional
+	IsTemplate *bool `json:"isTemplate,omitempty"`
+
+	// Topics: the repository topics; GitHub stores them lowercase.
+	// +optional
+	Topics []string `json:"topics,omitempty"`
+
+	// CustomProperties: the values of the organization custom properties;
+	// properties not listed are left untouched.
+	// +optional
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+
+	// MultiSelectCustomProperties: the values of the multi_select
+	// organization custom properties; properties not listed are left untouched.
+	// +optional
+	MultiSelectCustomProperties map[string][]string `json:"multiSelectCustomProperties,omitempty"`
+
+	// SecurityAndAnalysis: the security and analysis features;
+	// features not listed are left untouched.
+	// +optional
+	SecurityAndAnalysis *RepoSecurityAndAnalysis `json:"securityAndAnalysis,omitempty"`
+
+	// OnDelete: what happens to the repository when the resource is deleted (default: delete).
+	// +kubebuilder:validation:Enum=delete;archive;rename-and-archive;transfer-to-graveyard-org
+	// +optional
+	OnDelete *OnDeletePolicy `json:"onDelete,omitempty"`
+
+	// GraveyardOrg: the organization the repository is transferred
+	// to when onDelete is transfer-to-graveyard-org.
+	// +optional
+	GraveyardOrg *string `json:"graveyardOrg,omitempty"`
 }
 
 type RepoObservation struct {
+	// Id: repository unique identifier.
+	Id *int64 `json:"id,omitempty"`
+
+	// NodeId: repository GraphQL node identifier.
+	NodeId *string `json:"nodeId,omitempty"`
+
 	// Url: repository URL.
 	Url *string `json:"url,omitempty"`
 
+	// CloneUrl: repository HTTPS clone URL.
+	CloneUrl *string `json:"cloneUrl,omitempty"`
+
+	// SshUrl: repository SSH clone URL.
+	SshUrl *string `json:"sshUrl,omitempty"`
+
+	// DefaultBranch: repository default branch.
+	DefaultBranch *string `json:"defaultBranch,omitempty"`
+
 	// Private: whether the repository is private.
 	Private *bool `json:"private,omitempty"`
+
+	// Visibility: repository visibility (public, private or internal).
+	Visibility *string `json:"visibility,omitempty"`
+
+	// Archived: whether the repository is archived.
+	Archived *bool `json:"archived,omitempty"`
+
+	// Fork: whether the repository is a fork.
+	Fork *bool `json:"fork,omitempty"`
+
+	// Parent: the repository this repository is forked from (owner/repo).
+	Parent *string `json:"parent,omitempty"`
+
+	// Source: the root repository of the fork network (owner/repo).
+	Source *string `json:"source,omitempty"`
+
+	// Size: repository size in kilobytes.
+	Size *int64 `json:"size,omitempty"`
+
+	// PushedAt: time of the last push to the repository.
+	PushedAt *metav1.Time `json:"pushedAt,omitempty"`
+
+	// OpenIssuesCount: number of open issues.
+	OpenIssuesCount *int64 `json:"openIssuesCount,omitempty"`
+
+	// Topics: repository topics.
+	Topics []string `json:"topics,omitempty"`
+
+	// CustomProperties: the values of the managed custom properties.
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+
+	// MultiSelectCustomProperties: the values of the managed multi_select custom properties.
+	MultiSelectCustomProperties map[string][]string `json:"multiSelectCustomProperties,omitempty"`
+
+	// SecurityAndAnalysis: the state of the managed security and analysis features.
+	SecurityAndAnalysis *RepoSecurityAndAnalysis `json:"securityAndAnalysis,omitempty"`
+
+	// PendingTransfer: the owner the repository is being transferred to.
+	PendingTransfer *string `json:"pendingTransfer,omitempty"`
+
+	// PendingTransferSince: the time the pending transfer has been requested;
+	// transfers not completed within a day are requested again.
+	PendingTransferSince *metav1.Time `json:"pendingTransferSince,omitempty"`
 }
 
 // A RepoSpec defines the desired state of a Repo.
@@ -25,6 +25,21 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Repo) DeepCopyInto(out *Repo) {
 	*out = *in
@@ -52,6 +67,47 @@ func (in *Repo) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepoFork) DeepCopyInto(out *RepoFork) {
+	*out = *in
+	if in.DefaultBranchOnly != nil {
+		in, out := &in.DefaultBranchOnly, &out.DefaultBranchOnly
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoFork.
+func (in *RepoFork) DeepCopy() *RepoFork {
+	if in == nil {
+		return nil
+	}
+	out := new(RepoFork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepoInitialFile) DeepCopyInto(out *RepoInitialFile) {
+	*out = *in
+	out.ConfigMapKeyRef = in.ConfigMapKeyRef
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoInitialFile.
+func (in *RepoInitialFile) DeepCopy() *RepoInitialFile {
+	if in == nil {
+		return nil
+	}
+	out := new(RepoInitialFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoList) DeepCopyInto(out *RepoList) {
 	*out = *in
@@ -87,16 +143,121 @@ func (in *RepoList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoObservation) DeepCopyInto(out *RepoObservation) {
 	*out = *in
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NodeId != nil {
+		in, out := &in.NodeId, &out.NodeId
+		*out = new(string)
+		**out = **in
+	}
 	if in.Url != nil {
 		in, out := &in.Url, &out.Url
 		*out = new(string)
 		**out = **in
 	}
+	if in.CloneUrl != nil {
+		in, out := &in.CloneUrl, &out.CloneUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.SshUrl != nil {
+		in, out := &in.SshUrl, &out.SshUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultBranch != nil {
+		in, out := &in.DefaultBranch, &out.DefaultBranch
+		*out = new(string)
+		**out = **in
+	}
 	if in.Private != nil {
 		in, out := &in.Private, &out.Private
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(string)
+		**out = **in
+	}
+	if in.Archived != nil {
+		in, out := &in.Archived, &out.Archived
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Fork != nil {
+		in, out := &in.Fork, &out.Fork
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Parent != nil {
+		in, out := &in.Parent, &out.Parent
+		*out = new(string)
+		**out = **in
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PushedAt != nil {
+		in, out := &in.PushedAt, &out.PushedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.OpenIssuesCount != nil {
+		in, out := &in.OpenIssuesCount, &out.OpenIssuesCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomProperties != nil {
+		in, out := &in.CustomProperties, &out.CustomProperties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MultiSelectCustomProperties != nil {
+		in, out := &in.MultiSelectCustomProperties, &out.MultiSelectCustomProperties
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.SecurityAndAnalysis != nil {
+		in, out := &in.SecurityAndAnalysis, &out.SecurityAndAnalysis
+		*out = new(RepoSecurityAndAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingTransfer != nil {
+		in, out := &in.PendingTransfer, &out.PendingTransfer
+		*out = new(string)
+		**out = **in
+	}
+	if in.PendingTransferSince != nil {
+		in, out := &in.PendingTransferSince, &out.PendingTransferSince
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoObservation.
@@ -112,11 +273,175 @@ func (in *RepoObservation) DeepCopy() *RepoObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoParams) DeepCopyInto(out *RepoParams) {
 	*out = *in
+	if in.TransferTeamIds != nil {
+		in, out := &in.TransferTeamIds, &out.TransferTeamIds
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.Private != nil {
+		in, out := &in.Private, &out.Private
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Initialize != nil {
 		in, out := &in.Initialize, &out.Initialize
 		*out = new(bool)
 		**out = **in
 	}
+	if in.FromTemplate != nil {
+		in, out := &in.FromTemplate, &out.FromTemplate
+		*out = new(RepoTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FromFork != nil {
+		in, out := &in.FromFork, &out.FromFork
+		*out = new(RepoFork)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GitignoreTemplate != nil {
+		in, out := &in.GitignoreTemplate, &out.GitignoreTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.LicenseTemplate != nil {
+		in, out := &in.LicenseTemplate, &out.LicenseTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.InitialFiles != nil {
+		in, out := &in.InitialFiles, &out.InitialFiles
+		*out = make([]RepoInitialFile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Homepage != nil {
+		in, out := &in.Homepage, &out.Homepage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(string)
+		**out = **in
+	}
+	if in.HasIssues != nil {
+		in, out := &in.HasIssues, &out.HasIssues
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HasWiki != nil {
+		in, out := &in.HasWiki, &out.HasWiki
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HasProjects != nil {
+		in, out := &in.HasProjects, &out.HasProjects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HasDiscussions != nil {
+		in, out := &in.HasDiscussions, &out.HasDiscussions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowMergeCommit != nil {
+		in, out := &in.AllowMergeCommit, &out.AllowMergeCommit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowSquashMerge != nil {
+		in, out := &in.AllowSquashMerge, &out.AllowSquashMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowRebaseMerge != nil {
+		in, out := &in.AllowRebaseMerge, &out.AllowRebaseMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SquashMergeCommitTitle != nil {
+		in, out := &in.SquashMergeCommitTitle, &out.SquashMergeCommitTitle
+		*out = new(string)
+		**out = **in
+	}
+	if in.SquashMergeCommitMessage != nil {
+		in, out := &in.SquashMergeCommitMessage, &out.SquashMergeCommitMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeleteBranchOnMerge != nil {
+		in, out := &in.DeleteBranchOnMerge, &out.DeleteBranchOnMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowAutoMerge != nil {
+		in, out := &in.AllowAutoMerge, &out.AllowAutoMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowUpdateBranch != nil {
+		in, out := &in.AllowUpdateBranch, &out.AllowUpdateBranch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WebCommitSignoffRequired != nil {
+		in, out := &in.WebCommitSignoffRequired, &out.WebCommitSignoffRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsTemplate != nil {
+		in, out := &in.IsTemplate, &out.IsTemplate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomProperties != nil {
+		in, out := &in.CustomProperties, &out.CustomProperties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MultiSelectCustomProperties != nil {
+		in, out := &in.MultiSelectCustomProperties, &out.MultiSelectCustomProperties
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.SecurityAndAnalysis != nil {
+		in, out := &in.SecurityAndAnalysis, &out.SecurityAndAnalysis
+		*out = new(RepoSecurityAndAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnDelete != nil {
+		in, out := &in.OnDelete, &out.OnDelete
+		*out = new(OnDeletePolicy)
+		**out = **in
+	}
+	if in.GraveyardOrg != nil {
+		in, out := &in.GraveyardOrg, &out.GraveyardOrg
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoParams.
@@ -129,6 +454,46 @@ func (in *RepoParams) DeepCopy() *RepoParams {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepoSecurityAndAnalysis) DeepCopyInto(out *RepoSecurityAndAnalysis) {
+	*out = *in
+	if in.AdvancedSecurity != nil {
+		in, out := &in.AdvancedSecurity, &out.AdvancedSecurity
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretScanning != nil {
+		in, out := &in.SecretScanning, &out.SecretScanning
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretScanningPushProtection != nil {
+		in, out := &in.SecretScanningPushProtection, &out.SecretScanningPushProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DependabotSecurityUpdates != nil {
+		in, out := &in.DependabotSecurityUpdates, &out.DependabotSecurityUpdates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VulnerabilityAlerts != nil {
+		in, out := &in.VulnerabilityAlerts, &out.VulnerabilityAlerts
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoSecurityAndAnalysis.
+func (in *RepoSecurityAndAnalysis) DeepCopy() *RepoSecurityAndAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(RepoSecurityAndAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoSpec) DeepCopyInto(out *RepoSpec) {
 	*out = *in
@@ -162,3 +527,23 @@ func (in *RepoStatus) DeepCopy() *RepoStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepoTemplate) DeepCopyInto(out *RepoTemplate) {
+	*out = *in
+	if in.IncludeAllBranches != nil {
+		in, out := &in.IncludeAllBranches, &out.IncludeAllBranches
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoTemplate.
+func (in *RepoTemplate) DeepCopy() *RepoTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(RepoTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,34 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "github.krateo.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Ruleset type metadata.
+var (
+	RulesetKind             = reflect.TypeOf(Ruleset{}).Name()
+	RulesetGroupKind        = schema.GroupKind{Group: Group, Kind: RulesetKind}.String()
+	RulesetKindAPIVersion   = RulesetKind + "." + SchemeGroupVersion.String()
+	RulesetGroupVersionKind = SchemeGroupVersion.WithKind(RulesetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Ruleset{}, &RulesetList{})
+}
@@ -0,0 +1,231 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RulesetBypassActor is an actor that can bypass the ruleset.
+type RulesetBypassActor struct {
+	// ActorId: the id of the actor; not used by OrganizationAdmin.
+	// +optional
+	ActorId *int64 `json:"actorId,omitempty"`
+
+	// ActorType: the type of the actor.
+	// +kubebuilder:validation:Enum=Integration;OrganizationAdmin;RepositoryRole;Team;DeployKey
+	ActorType string `json:"actorType"`
+
+	// BypassMode: when the actor can bypass the ruleset (default: always).
+	// +kubebuilder:validation:Enum=always;pull_request
+	// +optional
+	BypassMode *string `json:"bypassMode,omitempty"`
+}
+
+// RulesetPatterns holds the patterns included and excluded by a condition.
+type RulesetPatterns struct {
+	// Include: the patterns to include; ~DEFAULT_BRANCH and ~ALL are accepted by ref names.
+	// +optional
+	Include []string `json:"include,omitempty"`
+
+	// Exclude: the patterns to exclude.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// RulesetConditions holds the refs and the repositories the ruleset applies to.
+type RulesetConditions struct {
+	// RefName: the branch or tag names the ruleset applies to.
+	// +optional
+	RefName *RulesetPatterns `json:"refName,omitempty"`
+
+	// RepositoryName: the repository names the ruleset applies to; only for organization rulesets.
+	// +optional
+	RepositoryName *RulesetPatterns `json:"repositoryName,omitempty"`
+}
+
+// RulesetPullRequestRule requires changes to be made through pull requests.
+type RulesetPullRequestRule struct {
+	// RequiredApprovingReviewCount: the number of approvals required (default: 0).
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	RequiredApprovingReviewCount *int `json:"requiredApprovingReviewCount,omitempty"`
+
+	// DismissStaleReviewsOnPush: whether new commits dismiss the approvals (default: false).
+	// +optional
+	DismissStaleReviewsOnPush *bool `json:"dismissStaleReviewsOnPush,omitempty"`
+
+	// RequireCodeOwnerReview: whether the code owners must approve (default: false).
+	// +optional
+	RequireCodeOwnerReview *bool `json:"requireCodeOwnerReview,omitempty"`
+
+	// RequireLastPushApproval: whether the most recent push must be approved
+	// by someone other than the person who pushed it (default: false).
+	// +optional
+	RequireLastPushApproval *bool `json:"requireLastPushApproval,omitempty"`
+
+	// RequiredReviewThreadResolution: whether all the conversations
+	// must be resolved before merging (default: false).
+	// +optional
+	RequiredReviewThreadResolution *bool `json:"requiredReviewThreadResolution,omitempty"`
+}
+
+// RulesetStatusCheck is a status check that must pass before merging.
+type RulesetStatusCheck struct {
+	// Context: the name of the status check.
+	Context string `json:"context"`
+
+	// IntegrationId: the id of the GitHub App that must provide the check.
+	// +optional
+	IntegrationId *int64 `json:"integrationId,omitempty"`
+}
+
+// RulesetRequiredStatusChecksRule requires status checks to pass before merging.
+type RulesetRequiredStatusChecksRule struct {
+	// Checks: the status checks that must pass.
+	Checks []RulesetStatusCheck `json:"checks"`
+
+	// Strict: whether branches must be up to date before merging (default: false).
+	// +optional
+	Strict *bool `json:"strict,omitempty"`
+}
+
+// RulesetCommitMessagePatternRule requires the commit messages to match a pattern.
+type RulesetCommitMessagePatternRule struct {
+	// Name: how the rule appears to the users.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Operator: how the pattern is matched.
+	// +kubebuilder:validation:Enum=starts_with;ends_with;contains;regex
+	Operator string `json:"operator"`
+
+	// Pattern: the pattern to match.
+	Pattern string `json:"pattern"`
+
+	// Negate: whether the rule fails when the pattern matches (default: false).
+	// +optional
+	Negate *bool `json:"negate,omitempty"`
+}
+
+// RulesetRules holds the rules of a ruleset; rules not set are not enforced.
+type RulesetRules struct {
+	// PullRequest: require changes to be made through pull requests.
+	// +optional
+	PullRequest *RulesetPullRequestRule `json:"pullRequest,omitempty"`
+
+	// RequiredStatusChecks: require status checks to pass before merging.
+	// +optional
+	RequiredStatusChecks *RulesetRequiredStatusChecksRule `json:"requiredStatusChecks,omitempty"`
+
+	// RequiredSignatures: require the commits to be signed.
+	// +optional
+	RequiredSignatures *bool `json:"requiredSignatures,omitempty"`
+
+	// NonFastForward: prevent force pushes.
+	// +optional
+	NonFastForward *bool `json:"nonFastForward,omitempty"`
+
+	// CommitMessagePattern: require the commit messages to match a pattern.
+	// +optional
+	CommitMessagePattern *RulesetCommitMessagePatternRule `json:"commitMessagePattern,omitempty"`
+
+	// RequiredDeployments: the environments that must be successfully deployed to before merging.
+	// +optional
+	RequiredDeployments []string `json:"requiredDeployments,omitempty"`
+
+	// RestrictedFilePaths: the file paths that cannot be changed by pushes.
+	// +optional
+	RestrictedFilePaths []string `json:"restrictedFilePaths,omitempty"`
+}
+
+type RulesetParams struct {
+	// Org: the organization or the repository owner.
+	// +immutable
+	Org string `json:"org"`
+
+	// Repo: the repository name; when not set the ruleset applies to the organization.
+	// +immutable
+	// +optional
+	Repo *string `json:"repo,omitempty"`
+
+	// Name: the ruleset name.
+	Name string `json:"name"`
+
+	// Target: the type of refs the ruleset applies to (default: branch).
+	// +kubebuilder:validation:Enum=branch;tag
+	// +optional
+	Target *string `json:"target,omitempty"`
+
+	// Enforcement: the enforcement mode; evaluate is only available with GitHub Enterprise (default: active).
+	// +kubebuilder:validation:Enum=active;evaluate;disabled
+	// +optional
+	Enforcement *string `json:"enforcement,omitempty"`
+
+	// BypassActors: the actors that can bypass the ruleset.
+	// +optional
+	BypassActors []RulesetBypassActor `json:"bypassActors,omitempty"`
+
+	// Conditions: the refs and the repositories the ruleset applies to.
+	// +optional
+	Conditions *RulesetConditions `json:"conditions,omitempty"`
+
+	// Rules: the rules enforced by the ruleset.
+	// +optional
+	Rules RulesetRules `json:"rules,omitempty"`
+}
+
+type RulesetObservation struct {
+	// Id: the ruleset unique identifier.
+	Id *int64 `json:"id,omitempty"`
+
+	// NodeId: the ruleset GraphQL node identifier.
+	NodeId *string `json:"nodeId,omitempty"`
+
+	// SourceType: the type of the ruleset owner (Repository or Organization).
+	SourceType *string `json:"sourceType,omitempty"`
+
+	// Enforcement: the enforcement mode.
+	Enforcement *string `json:"enforcement,omitempty"`
+
+	// Url: the ruleset web URL.
+	Url *string `json:"url,omitempty"`
+}
+
+// A RulesetSpec defines the desired state of a Ruleset.
+type RulesetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RulesetParams `json:"forProvider"`
+}
+
+// A RulesetStatus represents the observed state of a Ruleset.
+type RulesetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RulesetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Ruleset is a managed resource that represents a GitHub repository or organization ruleset
+// +kubebuilder:printcolumn:name="ID",type="integer",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="ENFORCEMENT",type="string",JSONPath=".status.atProvider.enforcement"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,krateo,github}
+type Ruleset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RulesetSpec   `json:"spec"`
+	Status RulesetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RulesetList contains a list of Ruleset.
+type RulesetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Ruleset `json:"items"`
+}
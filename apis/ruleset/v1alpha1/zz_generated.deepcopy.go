@@ -0,0 +1,439 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2022 Kiratech S.p.A.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ruleset) DeepCopyInto(out *Ruleset) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ruleset.
+func (in *Ruleset) DeepCopy() *Ruleset {
+	if in == nil {
+		return nil
+	}
+	out := new(Ruleset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Ruleset) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetBypassActor) DeepCopyInto(out *RulesetBypassActor) {
+	*out = *in
+	if in.ActorId != nil {
+		in, out := &in.ActorId, &out.ActorId
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BypassMode != nil {
+		in, out := &in.BypassMode, &out.BypassMode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetBypassActor.
+func (in *RulesetBypassActor) DeepCopy() *RulesetBypassActor {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetBypassActor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetCommitMessagePatternRule) DeepCopyInto(out *RulesetCommitMessagePatternRule) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Negate != nil {
+		in, out := &in.Negate, &out.Negate
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetCommitMessagePatternRule.
+func (in *RulesetCommitMessagePatternRule) DeepCopy() *RulesetCommitMessagePatternRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetCommitMessagePatternRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetConditions) DeepCopyInto(out *RulesetConditions) {
+	*out = *in
+	if in.RefName != nil {
+		in, out := &in.RefName, &out.RefName
+		*out = new(RulesetPatterns)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepositoryName != nil {
+		in, out := &in.RepositoryName, &out.RepositoryName
+		*out = new(RulesetPatterns)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetConditions.
+func (in *RulesetConditions) DeepCopy() *RulesetConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetList) DeepCopyInto(out *RulesetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Ruleset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetList.
+func (in *RulesetList) DeepCopy() *RulesetList {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RulesetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetObservation) DeepCopyInto(out *RulesetObservation) {
+	*out = *in
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NodeId != nil {
+		in, out := &in.NodeId, &out.NodeId
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceType != nil {
+		in, out := &in.SourceType, &out.SourceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enforcement != nil {
+		in, out := &in.Enforcement, &out.Enforcement
+		*out = new(string)
+		**out = **in
+	}
+	if in.Url != nil {
+		in, out := &in.Url, &out.Url
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetObservation.
+func (in *RulesetObservation) DeepCopy() *RulesetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetParams) DeepCopyInto(out *RulesetParams) {
+	*out = *in
+	if in.Repo != nil {
+		in, out := &in.Repo, &out.Repo
+		*out = new(string)
+		**out = **in
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enforcement != nil {
+		in, out := &in.Enforcement, &out.Enforcement
+		*out = new(string)
+		**out = **in
+	}
+	if in.BypassActors != nil {
+		in, out := &in.BypassActors, &out.BypassActors
+		*out = make([]RulesetBypassActor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = new(RulesetConditions)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Rules.DeepCopyInto(&out.Rules)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetParams.
+func (in *RulesetParams) DeepCopy() *RulesetParams {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetPatterns) DeepCopyInto(out *RulesetPatterns) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetPatterns.
+func (in *RulesetPatterns) DeepCopy() *RulesetPatterns {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetPatterns)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetPullRequestRule) DeepCopyInto(out *RulesetPullRequestRule) {
+	*out = *in
+	if in.RequiredApprovingReviewCount != nil {
+		in, out := &in.RequiredApprovingReviewCount, &out.RequiredApprovingReviewCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.DismissStaleReviewsOnPush != nil {
+		in, out := &in.DismissStaleReviewsOnPush, &out.DismissStaleReviewsOnPush
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireCodeOwnerReview != nil {
+		in, out := &in.RequireCodeOwnerReview, &out.RequireCodeOwnerReview
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireLastPushApproval != nil {
+		in, out := &in.RequireLastPushApproval, &out.RequireLastPushApproval
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequiredReviewThreadResolution != nil {
+		in, out := &in.RequiredReviewThreadResolution, &out.RequiredReviewThreadResolution
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetPullRequestRule.
+func (in *RulesetPullRequestRule) DeepCopy() *RulesetPullRequestRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetPullRequestRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetRequiredStatusChecksRule) DeepCopyInto(out *RulesetRequiredStatusChecksRule) {
+	*out = *in
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]RulesetStatusCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Strict != nil {
+		in, out := &in.Strict, &out.Strict
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRequiredStatusChecksRule.
+func (in *RulesetRequiredStatusChecksRule) DeepCopy() *RulesetRequiredStatusChecksRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetRequiredStatusChecksRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetRules) DeepCopyInto(out *RulesetRules) {
+	*out = *in
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(RulesetPullRequestRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequiredStatusChecks != nil {
+		in, out := &in.RequiredStatusChecks, &out.RequiredStatusChecks
+		*out = new(RulesetRequiredStatusChecksRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequiredSignatures != nil {
+		in, out := &in.RequiredSignatures, &out.RequiredSignatures
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NonFastForward != nil {
+		in, out := &in.NonFastForward, &out.NonFastForward
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CommitMessagePattern != nil {
+		in, out := &in.CommitMessagePattern, &out.CommitMessagePattern
+		*out = new(RulesetCommitMessagePatternRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequiredDeployments != nil {
+		in, out := &in.RequiredDeployments, &out.RequiredDeployments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestrictedFilePaths != nil {
+		in, out := &in.RestrictedFilePaths, &out.RestrictedFilePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRules.
+func (in *RulesetRules) DeepCopy() *RulesetRules {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetSpec) DeepCopyInto(out *RulesetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetSpec.
+func (in *RulesetSpec) DeepCopy() *RulesetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetStatus) DeepCopyInto(out *RulesetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetStatus.
+func (in *RulesetStatus) DeepCopy() *RulesetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetStatusCheck) DeepCopyInto(out *RulesetStatusCheck) {
+	*out = *in
+	if in.IntegrationId != nil {
+		in, out := &in.IntegrationId, &out.IntegrationId
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetStatusCheck.
+func (in *RulesetStatusCheck) DeepCopy() *RulesetStatusCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetStatusCheck)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,120 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TypeDrift resources report whether the external resource
+// has drifted from the desired state.
+const TypeDrift xpv1.ConditionType = "Drift"
+
+// Reasons a resource is or is not drifted.
+const (
+	ReasonDriftDetected xpv1.ConditionReason = "DriftDetected"
+	ReasonInSync        xpv1.ConditionReason = "InSync"
+)
+
+// DriftDetected returns a condition that indicates the external resource
+// differs from the desired state; the message lists the differences.
+func DriftDetected(details string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrift,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDriftDetected,
+		Message:            details,
+	}
+}
+
+// InSync returns a condition that indicates the external
+// resource matches the desired state.
+func InSync() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrift,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonInSync,
+	}
+}
+
+// TypeTransfer resources report the state of the last
+// transfer of the external resource to another owner.
+const TypeTransfer xpv1.ConditionType = "Transfer"
+
+// Reasons of the state of a transfer.
+const (
+	ReasonTransferPending   xpv1.ConditionReason = "TransferPending"
+	ReasonTransferCompleted xpv1.ConditionReason = "TransferCompleted"
+	ReasonTransferExpired   xpv1.ConditionReason = "TransferExpired"
+)
+
+// TransferPending returns a condition that indicates the transfer
+// has been requested and is waiting to be accepted by the new owner.
+func TransferPending(details string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTransfer,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTransferPending,
+		Message:            details,
+	}
+}
+
+// TransferCompleted returns a condition that indicates
+// the transfer has been completed.
+func TransferCompleted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTransfer,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTransferCompleted,
+	}
+}
+
+// TransferExpired returns a condition that indicates the transfer has not
+// been completed in time (i.e. rejected or never accepted by the new owner).
+func TransferExpired(details string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTransfer,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTransferExpired,
+		Message:            details,
+	}
+}
+
+// TypeObservable resources report whether all the desired fields can be
+// observed; the fields that cannot be observed are not checked for drift.
+const TypeObservable xpv1.ConditionType = "Observable"
+
+// Reasons a resource is or is not fully observable.
+const (
+	ReasonObservable          xpv1.ConditionReason = "Observable"
+	ReasonPartiallyObservable xpv1.ConditionReason = "PartiallyObservable"
+)
+
+// Observable returns a condition that indicates all
+// the desired fields can be observed.
+func Observable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeObservable,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonObservable,
+	}
+}
+
+// PartiallyObservable returns a condition that indicates some of the desired
+// fields cannot be observed (i.e. lacking permissions); the message lists them.
+func PartiallyObservable(details string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeObservable,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPartiallyObservable,
+		Message:            details,
+	}
+}
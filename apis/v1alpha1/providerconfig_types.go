@@ -8,11 +8,27 @@ import (
 
 // Credentials required to authenticate.
 type Credentials struct {
-	// Source of the ReST API Token.
-	// +kubebuilder:validation:Enum=None;Secret;Environment
+	// Source of the ReST API Token; use None for
+	// anonymous read-only access to public repositories.
+	// +kubebuilder:validation:Enum=None;Secret;Environment;Filesystem
 	Source xpv1.CredentialsSource `json:"source"`
 
 	xpv1.CommonCredentialSelectors `json:",inline"`
+
+	// App: authenticate as a GitHub App installation instead of using
+	// a personal access token; the referenced credentials must hold
+	// the GitHub App PEM private key.
+	// +optional
+	App *AppCredentials `json:"app,omitempty"`
+}
+
+// AppCredentials identifies the GitHub App installation to authenticate as.
+type AppCredentials struct {
+	// AppId: the GitHub App identifier.
+	AppId int64 `json:"appId"`
+
+	// InstallationId: the GitHub App installation identifier.
+	InstallationId int64 `json:"installationId"`
 }
 
 // A ProviderConfigSpec defines the desired state of a ProviderConfig.
@@ -0,0 +1,190 @@
+package clients
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// defaultCacheMaxEntries bounds the number of cached responses.
+	defaultCacheMaxEntries = 2048
+)
+
+var (
+	cacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "provider_github_http_cache_requests_total",
+		Help: "Number of cacheable GitHub API requests, partitioned by result (hit or miss).",
+	}, []string{"result"})
+
+	cacheEntries = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "provider_github_http_cache_entries",
+		Help: "Number of GitHub API responses held in the cache.",
+	})
+
+	// sharedCache is used by all the clients, since a
+	// new client is created for each reconciliation.
+	sharedCache = newResponseCache(defaultCacheMaxEntries)
+)
+
+func init() {
+	metrics.Registry.MustRegister(cacheRequests, cacheEntries)
+}
+
+// cachedResponse is a response stored with its validators.
+type cachedResponse struct {
+	key    string
+	status int
+	header http.Header
+	body   []byte
+}
+
+// responseCache is a bounded in-memory LRU store of responses.
+type responseCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+func newResponseCache(maxEntries int) *responseCache {
+	return &responseCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      map[string]*list.Element{},
+	}
+}
+
+func (c *responseCache) get(key string) (*cachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+
+	return el.Value.(*cachedResponse), true
+}
+
+func (c *responseCache) add(res *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[res.key]; ok {
+		el.Value = res
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[res.key] = c.ll.PushFront(res)
+	for c.ll.Len() > c.maxEntries {
+		el := c.ll.Back()
+		c.ll.Remove(el)
+		delete(c.items, el.Value.(*cachedResponse).key)
+	}
+
+	cacheEntries.Set(float64(c.ll.Len()))
+}
+
+// cacheTransport implements http.RoundTripper. It makes GET requests
+// conditional using the ETag or Last-Modified validators of the cached
+// responses; GitHub does not count 304 Not Modified against the rate limit.
+type cacheTransport struct {
+	http.RoundTripper
+	cache *responseCache
+}
+
+// RoundTrip serves the cached response when the nested RoundTripper
+// answers 304 Not Modified, otherwise it caches the fresh response.
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return t.RoundTripper.RoundTrip(req)
+	}
+
+	key := cacheKey(req)
+
+	cached, ok := t.cache.get(key)
+	if ok {
+		// RoundTrippers must not modify the original request.
+		req = req.Clone(req.Context())
+		if etag := cached.header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lm := cached.header.Get("Last-Modified"); lm != "" {
+			req.Header.Set("If-Modified-Since", lm)
+		}
+	}
+
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		cacheRequests.WithLabelValues("hit").Inc()
+		resp.Body.Close()
+		return cached.toResponse(req, resp.Header), nil
+	}
+
+	cacheRequests.WithLabelValues("miss").Inc()
+
+	if resp.StatusCode != http.StatusOK ||
+		(resp.Header.Get("ETag") == "" && resp.Header.Get("Last-Modified") == "") {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.cache.add(&cachedResponse{
+		key:    key,
+		status: resp.StatusCode,
+		header: resp.Header.Clone(),
+		body:   body,
+	})
+
+	return resp, nil
+}
+
+// toResponse returns the cached response updating the rate
+// limit headers with those of the 304 Not Modified response.
+func (c *cachedResponse) toResponse(req *http.Request, fresh http.Header) *http.Response {
+	header := c.header.Clone()
+	for k, v := range fresh {
+		if strings.HasPrefix(k, "X-Ratelimit-") {
+			header[k] = v
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", c.status, http.StatusText(c.status)),
+		StatusCode:    c.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
+
+// cacheKey returns the key of the request by token and URL.
+func cacheKey(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return fmt.Sprintf("%x|%s|%s", sum, req.Header.Get("Accept"), req.URL.String())
+}
@@ -0,0 +1,149 @@
+package clients
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// etagServer answers with an ETag per token, replying 304 Not Modified
+// to the requests carrying the current ETag, and counts the requests.
+type etagServer struct {
+	version int32
+	calls   int32
+	notMods int32
+}
+
+func (s *etagServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt32(&s.calls, 1)
+
+	token := r.Header.Get("Authorization")
+	etag := fmt.Sprintf(`"%s-%d"`, token, atomic.LoadInt32(&s.version))
+
+	w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(5000-atomic.LoadInt32(&s.calls)))
+	if r.Header.Get("If-None-Match") == etag {
+		atomic.AddInt32(&s.notMods, 1)
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("ETag", etag)
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "body of %s", etag)
+}
+
+func fetch(t *testing.T, rt http.RoundTripper, method, url, token string) (*http.Response, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", token)
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return resp, string(data)
+}
+
+func TestCacheTransportNotModified(t *testing.T) {
+	srv := &etagServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	rt := &cacheTransport{RoundTripper: ts.Client().Transport, cache: newResponseCache(8)}
+
+	_, first := fetch(t, rt, http.MethodGet, ts.URL+"/repos/acme/demo", "token a")
+
+	// The 304 Not Modified response is replayed from the cache,
+	// with the rate limit headers of the fresh response.
+	resp, second := fetch(t, rt, http.MethodGet, ts.URL+"/repos/acme/demo", "token a")
+	if resp.StatusCode != http.StatusOK || second != first {
+		t.Fatalf("expected the cached response, got %d %q", resp.StatusCode, second)
+	}
+	if n := atomic.LoadInt32(&srv.notMods); n != 1 {
+		t.Fatalf("expected 1 not modified response, got %d", n)
+	}
+	if got := resp.Header.Get("X-RateLimit-Remaining"); got != "4998" {
+		t.Fatalf("expected the fresh rate limit headers, got %q", got)
+	}
+
+	// A changed resource replaces the cached response.
+	atomic.AddInt32(&srv.version, 1)
+	_, third := fetch(t, rt, http.MethodGet, ts.URL+"/repos/acme/demo", "token a")
+	if third == first {
+		t.Fatal("expected the fresh response")
+	}
+	if _, fourth := fetch(t, rt, http.MethodGet, ts.URL+"/repos/acme/demo", "token a"); fourth != third {
+		t.Fatalf("expected the updated cached response, got %q", fourth)
+	}
+}
+
+func TestCacheTransportTokenIsolation(t *testing.T) {
+	srv := &etagServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	rt := &cacheTransport{RoundTripper: ts.Client().Transport, cache: newResponseCache(8)}
+
+	_, a := fetch(t, rt, http.MethodGet, ts.URL+"/repos/acme/demo", "token a")
+
+	// Responses cached for a token are never served to another one.
+	_, b := fetch(t, rt, http.MethodGet, ts.URL+"/repos/acme/demo", "token b")
+	if a == b {
+		t.Fatal("expected a response for token b")
+	}
+	if n := atomic.LoadInt32(&srv.notMods); n != 0 {
+		t.Fatalf("expected no conditional request across tokens, got %d", n)
+	}
+}
+
+func TestCacheTransportSkipped(t *testing.T) {
+	srv := &etagServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	cache := newResponseCache(8)
+	rt := &cacheTransport{RoundTripper: ts.Client().Transport, cache: cache}
+
+	// Only the GET requests are cached.
+	fetch(t, rt, http.MethodPost, ts.URL+"/orgs/acme/repos", "token a")
+	fetch(t, rt, http.MethodPost, ts.URL+"/orgs/acme/repos", "token a")
+	if n := atomic.LoadInt32(&srv.notMods); n != 0 {
+		t.Fatalf("expected no conditional request, got %d", n)
+	}
+	if n := cache.ll.Len(); n != 0 {
+		t.Fatalf("expected no cached response, got %d", n)
+	}
+}
+
+func TestResponseCacheEviction(t *testing.T) {
+	cache := newResponseCache(2)
+
+	cache.add(&cachedResponse{key: "a"})
+	cache.add(&cachedResponse{key: "b"})
+	cache.get("a")
+	cache.add(&cachedResponse{key: "c"})
+
+	// The least recently used response is evicted.
+	if _, ok := cache.get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	for _, k := range []string{"a", "c"} {
+		if _, ok := cache.get(k); !ok {
+			t.Errorf("expected %s to be cached", k)
+		}
+	}
+}
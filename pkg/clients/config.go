@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"os"
+	"strings"
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -44,24 +45,32 @@ func UseProviderConfig(ctx context.Context, k client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
-	if s := pc.Spec.Credentials.Source; s != xpv1.CredentialsSourceSecret {
-		return nil, fmt.Errorf("credentials source %s is not currently supported", s)
+	cd := pc.Spec.Credentials
+	if cd.Source == xpv1.CredentialsSourceNone && cd.App != nil {
+		return nil, fmt.Errorf("credentials source %s cannot be used with a GitHub App", cd.Source)
 	}
 
-	csr := pc.Spec.Credentials.SecretRef
-	if csr == nil {
-		return nil, fmt.Errorf("no credentials secret referenced")
-	}
-
-	token, err := helpers.GetSecret(ctx, k, csr.DeepCopy())
+	// Credentials are extracted at each connection, so rotated
+	// secrets, environment variables and mounted files are picked up.
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, k, cd.CommonCredentialSelectors)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "cannot get credentials")
 	}
+	token := strings.TrimSpace(string(data))
 
 	opts := &github.ClientOpts{
 		ApiURL: pc.Spec.ApiUrl,
 		Token:  token,
 	}
+
+	if app := pc.Spec.Credentials.App; app != nil {
+		opts.Token = ""
+		opts.App = &github.AppOpts{
+			AppID:          app.AppId,
+			InstallationID: app.InstallationId,
+			PrivateKey:     []byte(token),
+		}
+	}
 	opts.HttpClient = defaultClient()
 
 	verbose := helpers.IsBoolPtrEqualToBool(pc.Spec.Verbose, true)
@@ -120,16 +129,20 @@ const (
 	defaultConnectionTimeout  = 15 * time.Second
 )
 
-// defaultClient returns a new http.Client.
+// defaultClient returns a new http.Client, caching
+// responses to issue conditional requests.
 func defaultClient() *http.Client {
 	return &http.Client{
 		Timeout: defaultConnectionTimeout + defaultResponseTimeout,
-		Transport: &http.Transport{
-			MaxIdleConnsPerHost:   defaultMaxIdleConnections,
-			ResponseHeaderTimeout: defaultResponseTimeout,
-			DialContext: (&net.Dialer{
-				Timeout: defaultConnectionTimeout,
-			}).DialContext,
+		Transport: &cacheTransport{
+			RoundTripper: &http.Transport{
+				MaxIdleConnsPerHost:   defaultMaxIdleConnections,
+				ResponseHeaderTimeout: defaultResponseTimeout,
+				DialContext: (&net.Dialer{
+					Timeout: defaultConnectionTimeout,
+				}).DialContext,
+			},
+			cache: sharedCache,
 		},
 	}
 }
@@ -0,0 +1,251 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/carlmjohnson/requests"
+)
+
+const (
+	// appTokenExpiryMargin is how long before the expiration
+	// an installation access token is considered stale.
+	appTokenExpiryMargin = time.Minute
+	// appJWTLifetime is the lifetime of the JWT used to
+	// request installation access tokens (GitHub allows max 10 minutes).
+	appJWTLifetime = 9 * time.Minute
+	// appJWTClockDrift backdates the JWT issue time to allow for clock drift.
+	appJWTClockDrift = 60 * time.Second
+	// appTokenSourceIdleTTL is how long an unused token source is kept in
+	// the cache (i.e. after its ProviderConfig has been deleted).
+	appTokenSourceIdleTTL = 2 * time.Hour
+)
+
+// AppOpts holds the GitHub App installation credentials.
+type AppOpts struct {
+	AppID          int64
+	InstallationID int64
+	PrivateKey     []byte
+}
+
+// tokenSource supplies the token used to authorize API requests.
+type tokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// staticTokenSource always returns the same token (i.e. a personal access token).
+type staticTokenSource string
+
+func (s staticTokenSource) Token(_ context.Context) (string, error) {
+	return string(s), nil
+}
+
+// appTokenSource exchanges a signed JWT for a GitHub App installation
+// access token, caching it until just before its expiration.
+type appTokenSource struct {
+	client         *http.Client
+	apiUrl         string
+	apiExtraPath   string
+	appID          int64
+	installationID int64
+	key            *rsa.PrivateKey
+	keySum         [sha256.Size]byte
+	now            func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+
+	// lastUsed is guarded by appTokenSourcesMu.
+	lastUsed time.Time
+}
+
+var (
+	// appTokenSources caches the token sources by installation, so that
+	// installation access tokens outlive the Client built at each reconcile.
+	appTokenSources   = map[string]*appTokenSource{}
+	appTokenSourcesMu sync.Mutex
+	// appTokenSourcesNow is the clock used to evict idle token sources.
+	appTokenSourcesNow = time.Now
+)
+
+// newAppTokenSource returns the appTokenSource for the installation, creating it
+// if none is cached yet or if the private key has changed (i.e. rotated).
+// Token sources left unused for appTokenSourceIdleTTL are evicted.
+func newAppTokenSource(httpClient *http.Client, apiUrl, extraPath string, opts *AppOpts) (*appTokenSource, error) {
+	sum := sha256.Sum256(opts.PrivateKey)
+	id := fmt.Sprintf("%s%s|%d|%d", apiUrl, extraPath, opts.AppID, opts.InstallationID)
+
+	appTokenSourcesMu.Lock()
+	defer appTokenSourcesMu.Unlock()
+
+	now := appTokenSourcesNow()
+	for k, src := range appTokenSources {
+		if now.Sub(src.lastUsed) > appTokenSourceIdleTTL {
+			delete(appTokenSources, k)
+		}
+	}
+
+	if src, ok := appTokenSources[id]; ok && src.keySum == sum {
+		src.lastUsed = now
+		return src, nil
+	}
+
+	key, err := parseRSAPrivateKey(opts.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	src := &appTokenSource{
+		client:         httpClient,
+		apiUrl:         apiUrl,
+		apiExtraPath:   extraPath,
+		appID:          opts.AppID,
+		installationID: opts.InstallationID,
+		key:            key,
+		keySum:         sum,
+		now:            time.Now,
+		lastUsed:       now,
+	}
+	appTokenSources[id] = src
+
+	return src, nil
+}
+
+// Token returns the cached installation access token, refreshing it if expired.
+func (s *appTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.now().Add(appTokenExpiryMargin).Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	jwt, err := s.signJWT()
+	if err != nil {
+		return "", err
+	}
+
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("app/installations/%d/access_tokens", s.installationID))
+
+	res := struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}{}
+
+	err = requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPost).
+		Header("Authorization", fmt.Sprintf("Bearer %s", jwt)).
+		Accept("application/vnd.github+json").
+		AddValidator(ErrorJSON(&GithubError{}, 201)).
+		ToJSON(&res).
+		Fetch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("cannot get installation access token: %w", err)
+	}
+
+	s.token = res.Token
+	s.expiresAt = res.ExpiresAt
+
+	return s.token, nil
+}
+
+// signJWT returns a RS256 signed JWT that authenticates as the GitHub App.
+//
+// GitHub API docs: https://docs.github.com/en/apps/creating-github-apps/authenticating-with-a-github-app/generating-a-json-web-token-jwt-for-a-github-app
+func (s *appTokenSource) signJWT() (string, error) {
+	now := s.now()
+
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-appJWTClockDrift).Unix(),
+		"exp": now.Add(appJWTLifetime).Unix(),
+		"iss": strconv.FormatInt(s.appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+
+	sum := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", err
+	}
+
+	return unsigned + "." + enc.EncodeToString(sig), nil
+}
+
+// parseRSAPrivateKey decodes a PEM encoded PKCS1 or PKCS8 RSA private key.
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("cannot decode GitHub App private key: no PEM data found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse GitHub App private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("GitHub App private key is not an RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+// authTransport implements http.RoundTripper. It sets the
+// Authorization header of each request using the token source.
+type authTransport struct {
+	http.RoundTripper
+	source tokenSource
+}
+
+// RoundTrip authorizes the request and calls the nested RoundTripper.
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	if token == "" && req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return nil, fmt.Errorf("cannot %s %s: anonymous access is read-only", req.Method, req.URL.Path)
+	}
+
+	// RoundTrippers must not modify the original request.
+	req = req.Clone(req.Context())
+	if token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("token %s", token))
+	}
+
+	return t.RoundTripper.RoundTrip(req)
+}
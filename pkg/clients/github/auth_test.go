@@ -0,0 +1,217 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// installationServer is a stand-in for the GitHub App installation
+// access tokens endpoint; it verifies the JWT and counts the requests.
+type installationServer struct {
+	t         *testing.T
+	key       *rsa.PublicKey
+	appID     int64
+	now       func() time.Time
+	expiresIn time.Duration
+	calls     int32
+}
+
+func (s *installationServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := atomic.AddInt32(&s.calls, 1)
+
+	if r.Method != http.MethodPost || r.URL.Path != "/app/installations/42/access_tokens" {
+		s.t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	jwt := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if err := s.verifyJWT(jwt); err != nil {
+		s.t.Errorf("invalid JWT: %v", err)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"token":      fmt.Sprintf("ghs_token%d", n),
+		"expires_at": s.now().Add(s.expiresIn).UTC().Format(time.RFC3339),
+	})
+}
+
+func (s *installationServer) verifyJWT(jwt string) error {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("expected 3 parts, got %d", len(parts))
+	}
+
+	enc := base64.RawURLEncoding
+
+	header := map[string]string{}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return err
+	}
+	if header["alg"] != "RS256" || header["typ"] != "JWT" {
+		return fmt.Errorf("unexpected header: %v", header)
+	}
+
+	sig, err := enc.DecodeString(parts[2])
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(s.key, crypto.SHA256, sum[:], sig); err != nil {
+		return err
+	}
+
+	claims := struct {
+		Iat int64  `json:"iat"`
+		Exp int64  `json:"exp"`
+		Iss string `json:"iss"`
+	}{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return err
+	}
+
+	now := s.now().Unix()
+	if claims.Iss != fmt.Sprint(s.appID) {
+		return fmt.Errorf("iss: expected %d, got %q", s.appID, claims.Iss)
+	}
+	if claims.Iat > now-int64(appJWTClockDrift/time.Second) {
+		return fmt.Errorf("iat: expected backdated, got %d (now %d)", claims.Iat, now)
+	}
+	if claims.Exp <= now || claims.Exp-claims.Iat > int64((10*time.Minute)/time.Second) {
+		return fmt.Errorf("exp: invalid lifetime (iat %d, exp %d, now %d)", claims.Iat, claims.Exp, now)
+	}
+
+	return nil
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func generateKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key, pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+}
+
+func TestAppTokenSource(t *testing.T) {
+	key, data := generateKey(t)
+
+	clock := time.Now().Truncate(time.Second)
+	now := func() time.Time { return clock }
+
+	srv := &installationServer{t: t, key: &key.PublicKey, appID: 7, now: now, expiresIn: time.Hour}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	src, err := newAppTokenSource(ts.Client(), ts.URL, "", &AppOpts{AppID: 7, InstallationID: 42, PrivateKey: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	src.now = now
+
+	ctx := context.Background()
+
+	token, err := src.Token(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "ghs_token1" {
+		t.Fatalf("expected ghs_token1, got %q", token)
+	}
+
+	// The token is cached until just before its expiration.
+	clock = clock.Add(time.Hour - 2*appTokenExpiryMargin)
+	if token, err = src.Token(ctx); err != nil || token != "ghs_token1" {
+		t.Fatalf("expected cached ghs_token1, got %q (err: %v)", token, err)
+	}
+	if n := atomic.LoadInt32(&srv.calls); n != 1 {
+		t.Fatalf("expected 1 request, got %d", n)
+	}
+
+	// The token is refreshed within the expiry margin.
+	clock = clock.Add(appTokenExpiryMargin + time.Second)
+	if token, err = src.Token(ctx); err != nil || token != "ghs_token2" {
+		t.Fatalf("expected refreshed ghs_token2, got %q (err: %v)", token, err)
+	}
+	if n := atomic.LoadInt32(&srv.calls); n != 2 {
+		t.Fatalf("expected 2 requests, got %d", n)
+	}
+}
+
+func TestNewAppTokenSourceCache(t *testing.T) {
+	_, data := generateKey(t)
+	_, rotated := generateKey(t)
+
+	clock := time.Now()
+	appTokenSourcesNow = func() time.Time { return clock }
+	defer func() { appTokenSourcesNow = time.Now }()
+
+	opts := &AppOpts{AppID: 7, InstallationID: 43, PrivateKey: data}
+
+	a, err := newAppTokenSource(http.DefaultClient, "https://cache.test", "", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := newAppTokenSource(http.DefaultClient, "https://cache.test", "", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Fatal("expected the cached token source")
+	}
+
+	// A rotated key replaces the cached token source.
+	c, err := newAppTokenSource(http.DefaultClient, "https://cache.test", "",
+		&AppOpts{AppID: 7, InstallationID: 43, PrivateKey: rotated})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c == a {
+		t.Fatal("expected a new token source for the rotated key")
+	}
+
+	// Idle token sources are evicted.
+	clock = clock.Add(appTokenSourceIdleTTL + time.Minute)
+	if _, err := newAppTokenSource(http.DefaultClient, "https://other.test", "", opts); err != nil {
+		t.Fatal(err)
+	}
+
+	appTokenSourcesMu.Lock()
+	_, ok := appTokenSources["https://cache.test|7|43"]
+	appTokenSourcesMu.Unlock()
+	if ok {
+		t.Fatal("expected the idle token source to be evicted")
+	}
+}
@@ -0,0 +1,221 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/carlmjohnson/requests"
+	"github.com/krateoplatformops/provider-github/apis/branchprotection/v1alpha1"
+	"github.com/krateoplatformops/provider-github/pkg/helpers"
+)
+
+// BranchService provides methods for managing repository branches.
+type BranchService struct {
+	client       *http.Client
+	apiUrl       string
+	apiExtraPath string
+}
+
+// newBranchService returns a new BranchService.
+func newBranchService(httpClient *http.Client, apiUrl, extraPath string) *BranchService {
+	return &BranchService{
+		client:       httpClient,
+		apiUrl:       apiUrl,
+		apiExtraPath: extraPath,
+	}
+}
+
+// BranchProtection represents the protection rules of a branch.
+type BranchProtection struct {
+	URL                            string                        `json:"url"`
+	RequiredStatusChecks           *ProtectionStatusChecks       `json:"required_status_checks,omitempty"`
+	RequiredPullRequestReviews     *ProtectionPullRequestReviews `json:"required_pull_request_reviews,omitempty"`
+	EnforceAdmins                  *ProtectionSetting            `json:"enforce_admins,omitempty"`
+	Restrictions                   *ProtectionRestrictions       `json:"restrictions,omitempty"`
+	RequiredLinearHistory          *ProtectionSetting            `json:"required_linear_history,omitempty"`
+	AllowForcePushes               *ProtectionSetting            `json:"allow_force_pushes,omitempty"`
+	AllowDeletions                 *ProtectionSetting            `json:"allow_deletions,omitempty"`
+	RequiredConversationResolution *ProtectionSetting            `json:"required_conversation_resolution,omitempty"`
+	RequiredSignatures             *ProtectionSetting            `json:"required_signatures,omitempty"`
+}
+
+// ProtectionSetting is a branch protection rule that can be enabled or disabled.
+type ProtectionSetting struct {
+	Enabled bool `json:"enabled"`
+}
+
+// IsEnabled reports whether the rule is enabled.
+func (s *ProtectionSetting) IsEnabled() bool {
+	return s != nil && s.Enabled
+}
+
+// ProtectionStatusChecks represents the status checks required before merging.
+type ProtectionStatusChecks struct {
+	Strict bool                    `json:"strict"`
+	Checks []ProtectionStatusCheck `json:"checks"`
+}
+
+// ProtectionStatusCheck represents a status check required before merging.
+type ProtectionStatusCheck struct {
+	Context string `json:"context"`
+	AppID   *int64 `json:"app_id,omitempty"`
+}
+
+// ProtectionPullRequestReviews represents the pull request reviews required before merging.
+type ProtectionPullRequestReviews struct {
+	DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+	RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+	RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+	RequireLastPushApproval      bool `json:"require_last_push_approval"`
+}
+
+// ProtectionRestrictions represents who can push to a protected branch.
+type ProtectionRestrictions struct {
+	Users []struct {
+		Login string `json:"login"`
+	} `json:"users"`
+	Teams []struct {
+		Slug string `json:"slug"`
+	} `json:"teams"`
+	Apps []struct {
+		Slug string `json:"slug"`
+	} `json:"apps"`
+}
+
+// GetProtection fetches the protection of a branch; returns nil if the branch is not protected.
+//
+// GitHub API docs: https://docs.github.com/en/rest/branches/branch-protection#get-branch-protection
+func (s *BranchService) GetProtection(ctx context.Context, owner, repo, branch string) (*BranchProtection, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/branches/%s/protection", owner, repo, branch))
+
+	res := &BranchProtection{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(res).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UpdateProtection protects a branch replacing all its protection rules;
+// the required signatures are set by SetRequiredSignatures.
+//
+// GitHub API docs: https://docs.github.com/en/rest/branches/branch-protection#update-branch-protection
+func (s *BranchService) UpdateProtection(ctx context.Context, opts *v1alpha1.BranchProtectionParams) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/branches/%s/protection", opts.Org, opts.Repo, opts.Branch))
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPut).
+		BodyJSON(protectionBody(&opts.BranchProtectionRules)).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
+}
+
+// SetRequiredSignatures enables or disables the signed commits requirement of a protected branch.
+//
+// GitHub API docs: https://docs.github.com/en/rest/branches/branch-protection#create-commit-signature-protection
+func (s *BranchService) SetRequiredSignatures(ctx context.Context, owner, repo, branch string, enabled bool) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/branches/%s/protection/required_signatures", owner, repo, branch))
+
+	method, status := http.MethodPost, 200
+	if !enabled {
+		method, status = http.MethodDelete, 204
+	}
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(method).
+		AddValidator(ErrorJSON(&GithubError{}, status)).
+		Fetch(ctx)
+}
+
+// DeleteProtection removes all the protection rules of a branch.
+//
+// GitHub API docs: https://docs.github.com/en/rest/branches/branch-protection#delete-branch-protection
+func (s *BranchService) DeleteProtection(ctx context.Context, owner, repo, branch string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/branches/%s/protection", owner, repo, branch))
+
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodDelete).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// protectionBody returns the request body of the branch protection update;
+// the rules not set are disabled.
+func protectionBody(opts *v1alpha1.BranchProtectionRules) map[string]interface{} {
+	body := map[string]interface{}{
+		"required_status_checks":           nil,
+		"enforce_admins":                   helpers.BoolValue(opts.EnforceAdmins),
+		"required_pull_request_reviews":    nil,
+		"restrictions":                     nil,
+		"required_linear_history":          helpers.BoolValue(opts.RequiredLinearHistory),
+		"allow_force_pushes":               helpers.BoolValue(opts.AllowForcePushes),
+		"allow_deletions":                  helpers.BoolValue(opts.AllowDeletions),
+		"required_conversation_resolution": helpers.BoolValue(opts.RequiredConversationResolution),
+	}
+
+	if sc := opts.RequiredStatusChecks; sc != nil {
+		checks := make([]ProtectionStatusCheck, 0, len(sc.Checks))
+		for _, c := range sc.Checks {
+			checks = append(checks, ProtectionStatusCheck{Context: c.Context, AppID: c.AppId})
+		}
+		body["required_status_checks"] = map[string]interface{}{
+			"strict": helpers.BoolValue(sc.Strict),
+			"checks": checks,
+		}
+	}
+
+	if rv := opts.RequiredPullRequestReviews; rv != nil {
+		count := 1
+		if rv.RequiredApprovingReviewCount != nil {
+			count = *rv.RequiredApprovingReviewCount
+		}
+		body["required_pull_request_reviews"] = map[string]interface{}{
+			"required_approving_review_count": count,
+			"require_code_owner_reviews":      helpers.BoolValue(rv.RequireCodeOwnerReviews),
+			"dismiss_stale_reviews":           helpers.BoolValue(rv.DismissStaleReviews),
+			"require_last_push_approval":      helpers.BoolValue(rv.RequireLastPushApproval),
+		}
+	}
+
+	if r := opts.Restrictions; r != nil {
+		body["restrictions"] = map[string]interface{}{
+			"users": stringsOrEmpty(r.Users),
+			"teams": stringsOrEmpty(r.Teams),
+			"apps":  stringsOrEmpty(r.Apps),
+		}
+	}
+
+	return body
+}
+
+func stringsOrEmpty(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
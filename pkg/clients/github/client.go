@@ -4,31 +4,17 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 const (
 	defaultApiURL = "https://api.github.com/"
 )
 
-// GithubError represents a Github API error response
-// https://developer.github.com/v3/#client-errors
-type GithubError struct {
-	Message string `json:"message"`
-	Errors  []struct {
-		Resource string `json:"resource"`
-		Field    string `json:"field"`
-		Code     string `json:"code"`
-	} `json:"errors,omitempty"`
-	DocumentationURL string `json:"documentation_url"`
-}
-
-func (e GithubError) Error() string {
-	return fmt.Sprintf("github: %v %+v %v", e.Message, e.Errors, e.DocumentationURL)
-}
-
 type ClientOpts struct {
 	ApiURL     string
 	Token      string
+	App        *AppOpts
 	HttpClient *http.Client
 }
 
@@ -38,14 +24,18 @@ type Client struct {
 	apiExtraPath string
 	httpClient   *http.Client
 	repos        *RepoService
+	orgs         *OrgService
+	branches     *BranchService
+	rulesets     *RulesetService
+	git          *GitService
+	teams        *TeamService
 }
 
 // NewClient returns a new Github Client
-func NewClient(opts ClientOpts) *Client {
+func NewClient(opts ClientOpts) (*Client, error) {
 	res := &Client{
 		apiUrl:       defaultApiURL,
 		apiExtraPath: "",
-		httpClient:   opts.HttpClient,
 	}
 
 	if len(opts.ApiURL) > 0 {
@@ -59,11 +49,70 @@ func NewClient(opts ClientOpts) *Client {
 		}
 	}
 
-	res.repos = newRepoService(res.httpClient, res.apiUrl, res.apiExtraPath, opts.Token)
+	base := opts.HttpClient
+	if base == nil {
+		base = http.DefaultClient
+	}
 
-	return res
+	var src tokenSource = staticTokenSource(opts.Token)
+	if opts.App != nil {
+		app, err := newAppTokenSource(base, res.apiUrl, res.apiExtraPath, opts.App)
+		if err != nil {
+			return nil, err
+		}
+		src = app
+	}
+
+	res.httpClient = &http.Client{
+		Transport: &authTransport{
+			RoundTripper: &rateLimitTransport{
+				RoundTripper: transportOrDefault(base.Transport),
+				now:          time.Now,
+			},
+			source: src,
+		},
+		CheckRedirect: base.CheckRedirect,
+		Jar:           base.Jar,
+		Timeout:       base.Timeout,
+	}
+
+	res.repos = newRepoService(res.httpClient, res.apiUrl, res.apiExtraPath)
+	res.orgs = newOrgService(res.httpClient, res.apiUrl, res.apiExtraPath)
+	res.branches = newBranchService(res.httpClient, res.apiUrl, res.apiExtraPath)
+	res.rulesets = newRulesetService(res.httpClient, res.apiUrl, res.apiExtraPath)
+	res.git = newGitService(res.httpClient, res.apiUrl, res.apiExtraPath)
+	res.teams = newTeamService(res.httpClient, res.apiUrl, res.apiExtraPath)
+
+	return res, nil
+}
+
+func transportOrDefault(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		return http.DefaultTransport
+	}
+	return rt
 }
 
 func (c *Client) Repos() *RepoService {
 	return c.repos
 }
+
+func (c *Client) Orgs() *OrgService {
+	return c.orgs
+}
+
+func (c *Client) Branches() *BranchService {
+	return c.branches
+}
+
+func (c *Client) Rulesets() *RulesetService {
+	return c.rulesets
+}
+
+func (c *Client) Git() *GitService {
+	return c.git
+}
+
+func (c *Client) Teams() *TeamService {
+	return c.teams
+}
@@ -0,0 +1,51 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/carlmjohnson/requests"
+)
+
+// FileExists reports whether a file exists in the default branch of the repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/contents#get-repository-content
+func (s *RepoService) FileExists(ctx context.Context, owner, name, filePath string) (bool, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/contents", owner, name), filePath)
+
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CreateFile commits a new file to the default branch of the repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/contents#create-or-update-file-contents
+func (s *RepoService) CreateFile(ctx context.Context, owner, name, filePath, message string, content []byte) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/contents", owner, name), filePath)
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPut).
+		BodyJSON(map[string]interface{}{
+			"message": message,
+			"content": base64.StdEncoding.EncodeToString(content),
+		}).
+		AddValidator(ErrorJSON(&GithubError{}, 201)).
+		Fetch(ctx)
+}
@@ -0,0 +1,153 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors classifying the GitHub API errors;
+// use errors.Is to check an error against them.
+var (
+	ErrNotFound         = errors.New("github: not found")
+	ErrUnauthorized     = errors.New("github: unauthorized")
+	ErrForbidden        = errors.New("github: forbidden")
+	ErrValidationFailed = errors.New("github: validation failed")
+	ErrConflict         = errors.New("github: conflict")
+	ErrRateLimited      = errors.New("github: rate limited")
+	ErrServerError      = errors.New("github: server error")
+)
+
+// GithubErrorDetail describes a field validation error.
+type GithubErrorDetail struct {
+	Resource string `json:"resource"`
+	Field    string `json:"field"`
+	Code     string `json:"code"`
+	Message  string `json:"message,omitempty"`
+}
+
+// GithubError represents a Github API error response
+// https://developer.github.com/v3/#client-errors
+type GithubError struct {
+	StatusCode       int                 `json:"-"`
+	Message          string              `json:"message"`
+	Errors           []GithubErrorDetail `json:"errors,omitempty"`
+	DocumentationURL string              `json:"documentation_url"`
+}
+
+func (e *GithubError) Error() string {
+	return fmt.Sprintf("github: %v %+v %v", e.Message, e.Errors, e.DocumentationURL)
+}
+
+// Reason returns a short CamelCase reason describing the error,
+// suitable for condition reasons (see Reason).
+func (e *GithubError) Reason() string {
+	return Reason(e)
+}
+
+// Is reports whether the error matches one of the sentinel errors.
+func (e *GithubError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrValidationFailed:
+		return e.StatusCode == http.StatusUnprocessableEntity
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// Is reports whether the error matches ErrRateLimited.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// Reason returns the RateLimited reason, suitable for condition reasons.
+func (e *RateLimitError) Reason() string {
+	return Reason(e)
+}
+
+// Reason returns a short CamelCase reason describing the error,
+// i.e. InvalidName for a validation error on the name field.
+func Reason(err error) string {
+	var gerr *GithubError
+	if errors.As(err, &gerr) && errors.Is(gerr, ErrValidationFailed) {
+		for _, d := range gerr.Errors {
+			if d.Field != "" {
+				return "Invalid" + camelCase(d.Field)
+			}
+		}
+	}
+
+	switch {
+	case errors.Is(err, ErrRateLimited):
+		return "RateLimited"
+	case errors.Is(err, ErrNotFound):
+		return "NotFound"
+	case errors.Is(err, ErrUnauthorized):
+		return "Unauthorized"
+	case errors.Is(err, ErrForbidden):
+		return "Forbidden"
+	case errors.Is(err, ErrValidationFailed):
+		return "ValidationFailed"
+	case errors.Is(err, ErrConflict):
+		return "Conflict"
+	case errors.Is(err, ErrServerError):
+		return "ServerError"
+	}
+	return "Unknown"
+}
+
+// reasoner is implemented by the errors describing a GitHub API failure.
+type reasoner interface {
+	Reason() string
+}
+
+// reasonError prefixes the wrapped error message with its reason.
+type reasonError struct {
+	reason string
+	err    error
+}
+
+func (e *reasonError) Error() string {
+	return e.reason + ": " + e.err.Error()
+}
+
+func (e *reasonError) Unwrap() error {
+	return e.err
+}
+
+// WithReason wraps err so that its message starts with the Reason of the
+// GitHub API failure, i.e. "InvalidName: github: Repository creation failed";
+// the reason then shows in the ReconcileError condition message.
+// Errors not returned by the GitHub API are returned unchanged.
+func WithReason(err error) error {
+	var r reasoner
+	if !errors.As(err, &r) {
+		return err
+	}
+	var re *reasonError
+	if errors.As(err, &re) {
+		return err
+	}
+	return &reasonError{reason: r.Reason(), err: err}
+}
+
+// camelCase converts a snake_case field name to CamelCase.
+func camelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,106 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/carlmjohnson/requests"
+)
+
+func TestReason(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		body   string
+		want   string
+	}{
+		"Unauthorized": {
+			status: http.StatusUnauthorized,
+			body:   `{"message":"Bad credentials","documentation_url":"https://docs.github.com/rest"}`,
+			want:   "Unauthorized",
+		},
+		"Forbidden": {
+			status: http.StatusForbidden,
+			body:   `{"message":"Resource not accessible by integration"}`,
+			want:   "Forbidden",
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+			body:   `{"message":"Not Found"}`,
+			want:   "NotFound",
+		},
+		"Conflict": {
+			status: http.StatusConflict,
+			body:   `{"message":"Git Repository is empty."}`,
+			want:   "Conflict",
+		},
+		"InvalidField": {
+			status: http.StatusUnprocessableEntity,
+			body:   `{"message":"Repository creation failed.","errors":[{"resource":"Repository","code":"custom","field":"default_branch","message":"name already exists on this account"}]}`,
+			want:   "InvalidDefaultBranch",
+		},
+		"ValidationFailed": {
+			status: http.StatusUnprocessableEntity,
+			body:   `{"message":"Validation Failed","errors":[{"resource":"Repository","code":"custom"}]}`,
+			want:   "ValidationFailed",
+		},
+		"ServerError": {
+			status: http.StatusBadGateway,
+			body:   `not json`,
+			want:   "ServerError",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer ts.Close()
+
+			err := requests.URL(ts.URL).Client(ts.Client()).
+				AddValidator(ErrorJSON(&GithubError{}, http.StatusOK)).
+				Fetch(context.Background())
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if got := Reason(err); got != tc.want {
+				t.Errorf("want reason %q, got %q", tc.want, got)
+			}
+
+			werr := WithReason(err)
+			if !strings.HasPrefix(werr.Error(), tc.want+": ") {
+				t.Errorf("expected the message prefixed by %q, got %q", tc.want, werr.Error())
+			}
+			if WithReason(werr) != werr {
+				t.Error("expected the reason to be added only once")
+			}
+			var gerr *GithubError
+			if !errors.As(werr, &gerr) || gerr.StatusCode != tc.status {
+				t.Errorf("expected the wrapped GithubError with status %d", tc.status)
+			}
+		})
+	}
+}
+
+func TestWithReason(t *testing.T) {
+	if WithReason(nil) != nil {
+		t.Error("expected nil")
+	}
+
+	err := errors.New("boom")
+	if WithReason(err) != err {
+		t.Error("expected errors not returned by the GitHub API unchanged")
+	}
+
+	rle := &RateLimitError{Secondary: true}
+	if werr := WithReason(rle); !strings.HasPrefix(werr.Error(), "RateLimited: ") || !errors.Is(werr, ErrRateLimited) {
+		t.Errorf("expected the RateLimited reason, got %q", werr.Error())
+	}
+}
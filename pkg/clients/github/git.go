@@ -0,0 +1,114 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/carlmjohnson/requests"
+)
+
+// GitService provides methods for managing git objects such as references.
+type GitService struct {
+	client       *http.Client
+	apiUrl       string
+	apiExtraPath string
+}
+
+// newGitService returns a new GitService.
+func newGitService(httpClient *http.Client, apiUrl, extraPath string) *GitService {
+	return &GitService{
+		client:       httpClient,
+		apiUrl:       apiUrl,
+		apiExtraPath: extraPath,
+	}
+}
+
+// Reference represents a git reference.
+type Reference struct {
+	Ref    string `json:"ref"`
+	Object struct {
+		Type string `json:"type"`
+		SHA  string `json:"sha"`
+	} `json:"object"`
+}
+
+// GetBranchRef fetches the reference of a branch; returns nil if the branch does not exist.
+//
+// GitHub API docs: https://docs.github.com/en/rest/git/refs#get-a-reference
+func (s *GitService) GetBranchRef(ctx context.Context, owner, repo, branch string) (*Reference, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/git/ref/heads/%s", owner, repo, branch))
+
+	res := &Reference{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(res).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CreateBranchRef creates a branch pointing to the commit.
+//
+// GitHub API docs: https://docs.github.com/en/rest/git/refs#create-a-reference
+func (s *GitService) CreateBranchRef(ctx context.Context, owner, repo, branch, sha string) (*Reference, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/git/refs", owner, repo))
+
+	res := &Reference{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPost).
+		BodyJSON(map[string]interface{}{
+			"ref": fmt.Sprintf("refs/heads/%s", branch),
+			"sha": sha,
+		}).
+		AddValidator(ErrorJSON(&GithubError{}, 201)).
+		ToJSON(res).
+		Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteBranchRef deletes a branch.
+//
+// GitHub API docs: https://docs.github.com/en/rest/git/refs#delete-a-reference
+func (s *GitService) DeleteBranchRef(ctx context.Context, owner, repo, branch string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/git/refs/heads/%s", owner, repo, branch))
+
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodDelete).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+
+		// GitHub answers 422 Reference does not exist for missing branches.
+		var ge *GithubError
+		if errors.As(err, &ge) && errors.Is(err, ErrValidationFailed) &&
+			strings.Contains(ge.Message, "Reference does not exist") {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
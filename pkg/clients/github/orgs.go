@@ -0,0 +1,150 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/carlmjohnson/requests"
+	"github.com/krateoplatformops/provider-github/apis/org/v1alpha1"
+)
+
+// OrgService provides methods for managing organization settings.
+type OrgService struct {
+	client       *http.Client
+	apiUrl       string
+	apiExtraPath string
+}
+
+// newOrgService returns a new OrgService.
+func newOrgService(httpClient *http.Client, apiUrl, extraPath string) *OrgService {
+	return &OrgService{
+		client:       httpClient,
+		apiUrl:       apiUrl,
+		apiExtraPath: extraPath,
+	}
+}
+
+// Organization represents a GitHub organization.
+type Organization struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+}
+
+// Get fetches an organization by login; returns nil if it does not exist.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/orgs#get-an-organization
+func (s *OrgService) Get(ctx context.Context, org string) (*Organization, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s", org))
+
+	res := &Organization{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(res).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CustomProperty represents an organization custom property definition.
+type CustomProperty struct {
+	PropertyName     string      `json:"property_name"`
+	ValueType        string      `json:"value_type"`
+	Required         bool        `json:"required"`
+	DefaultValue     interface{} `json:"default_value"`
+	Description      string      `json:"description"`
+	AllowedValues    []string    `json:"allowed_values"`
+	ValuesEditableBy string      `json:"values_editable_by"`
+}
+
+// GetCustomProperty fetches a custom property definition; returns nil if it does not exist.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/custom-properties#get-a-custom-property-for-an-organization
+func (s *OrgService) GetCustomProperty(ctx context.Context, org, name string) (*CustomProperty, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/properties/schema/%s", org, name))
+
+	res := &CustomProperty{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(res).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CreateOrUpdateCustomProperty creates a custom property definition or updates the existing one.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/custom-properties#create-or-update-a-custom-property-for-an-organization
+func (s *OrgService) CreateOrUpdateCustomProperty(ctx context.Context, opts *v1alpha1.OrgCustomPropertySchemaParams) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/properties/schema/%s", opts.Org, opts.Name))
+
+	body := map[string]interface{}{
+		"value_type": opts.ValueType,
+	}
+	if opts.Required != nil {
+		body["required"] = *opts.Required
+	}
+	if opts.ValueType == "multi_select" && opts.DefaultValues != nil {
+		body["default_value"] = opts.DefaultValues
+	} else if opts.DefaultValue != nil {
+		body["default_value"] = *opts.DefaultValue
+	}
+	if opts.Description != nil {
+		body["description"] = *opts.Description
+	}
+	if opts.AllowedValues != nil {
+		body["allowed_values"] = opts.AllowedValues
+	}
+	if opts.ValuesEditableBy != nil {
+		body["values_editable_by"] = *opts.ValuesEditableBy
+	}
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPut).
+		BodyJSON(body).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
+}
+
+// DeleteCustomProperty removes a custom property definition and its values from all the repositories.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/custom-properties#remove-a-custom-property-for-an-organization
+func (s *OrgService) DeleteCustomProperty(ctx context.Context, org, name string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/properties/schema/%s", org, name))
+
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodDelete).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/carlmjohnson/requests"
+)
+
+// customPropertyValue is the value of a custom property of a repository;
+// a string, or a list of strings for multi_select properties.
+type customPropertyValue struct {
+	PropertyName string      `json:"property_name"`
+	Value        interface{} `json:"value"`
+}
+
+// CustomPropertyValues holds the values of the custom properties of a repository.
+type CustomPropertyValues struct {
+	// Values holds the values of the string, single_select and true_false properties.
+	Values map[string]string
+	// MultiSelect holds the values of the multi_select properties.
+	MultiSelect map[string][]string
+}
+
+// GetCustomPropertyValues fetches the values of the custom properties of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/custom-properties#get-all-custom-property-values-for-a-repository
+func (s *RepoService) GetCustomPropertyValues(ctx context.Context, owner, name string) (*CustomPropertyValues, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/properties/values", owner, name))
+
+	values := []customPropertyValue{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(&values).
+		Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &CustomPropertyValues{
+		Values:      map[string]string{},
+		MultiSelect: map[string][]string{},
+	}
+	for _, v := range values {
+		switch val := v.Value.(type) {
+		case string:
+			res.Values[v.PropertyName] = val
+		case []interface{}:
+			list := make([]string, 0, len(val))
+			for _, el := range val {
+				list = append(list, fmt.Sprint(el))
+			}
+			res.MultiSelect[v.PropertyName] = list
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateCustomPropertyValues creates or updates the values of the custom properties
+// of a repository; the values of multi_select properties are sent as lists.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/custom-properties#create-or-update-custom-property-values-for-a-repository
+func (s *RepoService) UpdateCustomPropertyValues(ctx context.Context, owner, name string, values *CustomPropertyValues) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/properties/values", owner, name))
+
+	props := make([]customPropertyValue, 0, len(values.Values)+len(values.MultiSelect))
+	for k, v := range values.Values {
+		props = append(props, customPropertyValue{PropertyName: k, Value: v})
+	}
+	for k, v := range values.MultiSelect {
+		props = append(props, customPropertyValue{PropertyName: k, Value: v})
+	}
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPatch).
+		BodyJSON(map[string]interface{}{
+			"properties": props,
+		}).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+}
@@ -0,0 +1,224 @@
+package github
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitMaxDelay is the longest wait for a rate limit reset
+	// before failing fast with a RateLimitError.
+	rateLimitMaxDelay = 5 * time.Second
+	// secondaryRateLimitDefaultWait is the wait applied when a secondary
+	// rate limit response carries no Retry-After header.
+	secondaryRateLimitDefaultWait = time.Minute
+	// rateLimitIdleTTL is how long the rate limit state of an unused
+	// token is kept; the installation tokens are rotated hourly.
+	rateLimitIdleTTL = time.Hour
+)
+
+// RateLimitError is returned when a GitHub API primary or secondary rate limit is exceeded.
+//
+// GitHub API docs: https://docs.github.com/en/rest/overview/resources-in-the-rest-api#rate-limiting
+type RateLimitError struct {
+	// Secondary is true for secondary (abuse) rate limits.
+	Secondary bool
+	// Limit is the maximum number of requests per hour.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is the time at which requests can be resumed.
+	Reset time.Time
+	// Message is the GitHub error message, if any.
+	Message string
+}
+
+func (e *RateLimitError) Error() string {
+	kind := "primary"
+	if e.Secondary {
+		kind = "secondary"
+	}
+	return fmt.Sprintf("github: %s rate limit exceeded (limit: %d, remaining: %d), retry after %s",
+		kind, e.Limit, e.Remaining, e.Reset.Format(time.RFC3339))
+}
+
+// rateLimit is the last rate limit state observed for a token.
+type rateLimit struct {
+	limit     int
+	remaining int
+	reset     time.Time
+	secondary bool
+	// used is the last time a response was received for the token.
+	used time.Time
+}
+
+// blocked returns true if no request can be made before the reset.
+func (r *rateLimit) blocked(now time.Time) bool {
+	return (r.secondary || r.remaining == 0) && now.Before(r.reset)
+}
+
+var (
+	// rateLimits tracks the rate limit state by token, since
+	// GitHub accounts the budget per user or installation.
+	rateLimits   = map[string]*rateLimit{}
+	rateLimitsMu sync.Mutex
+)
+
+// rateLimitTransport implements http.RoundTripper. It tracks the rate limit
+// budget of each token, delaying or failing fast requests that would exceed it.
+type rateLimitTransport struct {
+	http.RoundTripper
+	now func() time.Time
+}
+
+// RoundTrip checks the rate limit budget before and after calling the nested RoundTripper.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := rateLimitKey(req)
+
+	if rl := lookupRateLimit(key); rl != nil && rl.blocked(t.now()) {
+		wait := rl.reset.Sub(t.now())
+		if wait > rateLimitMaxDelay {
+			return nil, rl.toError("")
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	rl := parseRateLimit(resp.Header)
+
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+
+		if e := t.checkRateLimited(resp, rl, data); e != nil {
+			storeRateLimit(key, rl, t.now())
+			return nil, e
+		}
+	}
+
+	storeRateLimit(key, rl, t.now())
+
+	return resp, nil
+}
+
+// checkRateLimited returns a RateLimitError if the response
+// reports a primary or secondary rate limit, updating rl.
+func (t *rateLimitTransport) checkRateLimited(resp *http.Response, rl *rateLimit, body []byte) *RateLimitError {
+	ge := GithubError{}
+	_ = json.Unmarshal(body, &ge)
+
+	if ra := resp.Header.Get("Retry-After"); ra != "" {
+		secs, err := strconv.Atoi(ra)
+		if err != nil {
+			secs = int(secondaryRateLimitDefaultWait.Seconds())
+		}
+		*rl = rateLimit{
+			limit:     rl.limit,
+			remaining: rl.remaining,
+			reset:     t.now().Add(time.Duration(secs) * time.Second),
+			secondary: true,
+		}
+		return rl.toError(ge.Message)
+	}
+
+	if rl.remaining == 0 && !rl.reset.IsZero() {
+		return rl.toError(ge.Message)
+	}
+
+	if strings.Contains(strings.ToLower(ge.Message), "secondary rate limit") {
+		*rl = rateLimit{
+			limit:     rl.limit,
+			remaining: rl.remaining,
+			reset:     t.now().Add(secondaryRateLimitDefaultWait),
+			secondary: true,
+		}
+		return rl.toError(ge.Message)
+	}
+
+	return nil
+}
+
+func (r *rateLimit) toError(msg string) *RateLimitError {
+	return &RateLimitError{
+		Secondary: r.secondary,
+		Limit:     r.limit,
+		Remaining: r.remaining,
+		Reset:     r.reset,
+		Message:   msg,
+	}
+}
+
+// parseRateLimit returns the rate limit state reported by the response headers.
+// When the headers are missing the returned state is unlimited.
+func parseRateLimit(h http.Header) *rateLimit {
+	res := &rateLimit{remaining: -1}
+
+	if v, err := strconv.Atoi(h.Get("X-RateLimit-Limit")); err == nil {
+		res.limit = v
+	}
+	if v, err := strconv.Atoi(h.Get("X-RateLimit-Remaining")); err == nil {
+		res.remaining = v
+	}
+	if v, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		res.reset = time.Unix(v, 0)
+	}
+
+	return res
+}
+
+// rateLimitKey returns the key of the token authorizing the request.
+func rateLimitKey(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return fmt.Sprintf("%s|%x", req.URL.Host, sum)
+}
+
+func lookupRateLimit(key string) *rateLimit {
+	rateLimitsMu.Lock()
+	defer rateLimitsMu.Unlock()
+
+	rl, ok := rateLimits[key]
+	if !ok {
+		return nil
+	}
+	res := *rl
+	return &res
+}
+
+// storeRateLimit records the rate limit state of the token, evicting the
+// states of the tokens left unused for rateLimitIdleTTL once their reset is past.
+func storeRateLimit(key string, rl *rateLimit, now time.Time) {
+	rateLimitsMu.Lock()
+	defer rateLimitsMu.Unlock()
+
+	for k, v := range rateLimits {
+		if now.Sub(v.used) > rateLimitIdleTTL && !now.Before(v.reset) {
+			delete(rateLimits, k)
+		}
+	}
+
+	rl.used = now
+	rateLimits[key] = rl
+}
@@ -0,0 +1,230 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// rateLimitServer answers with the supplied handler and counts the requests.
+type rateLimitServer struct {
+	handler http.HandlerFunc
+	calls   int32
+}
+
+func (s *rateLimitServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt32(&s.calls, 1)
+	s.handler(w, r)
+}
+
+func newRateLimitTransport(ts *httptest.Server, now *time.Time) *rateLimitTransport {
+	return &rateLimitTransport{
+		RoundTripper: ts.Client().Transport,
+		now:          func() time.Time { return *now },
+	}
+}
+
+func get(t *testing.T, rt http.RoundTripper, url, token string) (*http.Response, error) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return rt.RoundTrip(req)
+}
+
+func TestRateLimitTransportPrimary(t *testing.T) {
+	clock := time.Now().Truncate(time.Second)
+	reset := clock.Add(time.Hour)
+
+	srv := &rateLimitServer{handler: func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"API rate limit exceeded for installation ID 42."}`)
+	}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	rt := newRateLimitTransport(ts, &clock)
+
+	_, err := get(t, rt, ts.URL, "primary")
+	var rle *RateLimitError
+	if !errors.As(err, &rle) {
+		t.Fatalf("expected a RateLimitError, got %v", err)
+	}
+	if rle.Secondary || rle.Limit != 5000 || rle.Remaining != 0 || !rle.Reset.Equal(reset) {
+		t.Fatalf("unexpected rate limit error: %+v", rle)
+	}
+
+	// The next requests fail fast until the reset.
+	if _, err := get(t, rt, ts.URL, "primary"); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if n := atomic.LoadInt32(&srv.calls); n != 1 {
+		t.Fatalf("expected 1 request, got %d", n)
+	}
+
+	// Other tokens have their own budget.
+	if _, err := get(t, rt, ts.URL, "other"); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if n := atomic.LoadInt32(&srv.calls); n != 2 {
+		t.Fatalf("expected the other token to reach the server, got %d requests", n)
+	}
+
+	// Requests are resumed after the reset.
+	clock = reset.Add(time.Second)
+	srv.handler = func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.WriteHeader(http.StatusOK)
+	}
+	resp, err := get(t, rt, ts.URL, "primary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if n := atomic.LoadInt32(&srv.calls); n != 3 {
+		t.Fatalf("expected 3 requests, got %d", n)
+	}
+}
+
+func TestRateLimitTransportSecondary(t *testing.T) {
+	cases := map[string]struct {
+		status    int
+		header    map[string]string
+		body      string
+		wantReset time.Duration
+	}{
+		"RetryAfter": {
+			status:    http.StatusTooManyRequests,
+			header:    map[string]string{"Retry-After": "120"},
+			wantReset: 120 * time.Second,
+		},
+		"InvalidRetryAfter": {
+			status:    http.StatusForbidden,
+			header:    map[string]string{"Retry-After": "soon"},
+			wantReset: secondaryRateLimitDefaultWait,
+		},
+		"Message": {
+			status:    http.StatusForbidden,
+			body:      `{"message":"You have exceeded a secondary rate limit. Please wait a few minutes before you try again."}`,
+			wantReset: secondaryRateLimitDefaultWait,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			clock := time.Now()
+
+			srv := &rateLimitServer{handler: func(w http.ResponseWriter, r *http.Request) {
+				for k, v := range tc.header {
+					w.Header().Set(k, v)
+				}
+				w.Header().Set("X-RateLimit-Remaining", "100")
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+			}}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			rt := newRateLimitTransport(ts, &clock)
+
+			_, err := get(t, rt, ts.URL, "secondary")
+			var rle *RateLimitError
+			if !errors.As(err, &rle) {
+				t.Fatalf("expected a RateLimitError, got %v", err)
+			}
+			if !rle.Secondary || !rle.Reset.Equal(clock.Add(tc.wantReset)) {
+				t.Fatalf("unexpected rate limit error: %+v", rle)
+			}
+
+			// Secondary limits block the token even with a remaining budget.
+			if _, err := get(t, rt, ts.URL, "secondary"); !errors.Is(err, ErrRateLimited) {
+				t.Fatalf("expected ErrRateLimited, got %v", err)
+			}
+			if n := atomic.LoadInt32(&srv.calls); n != 1 {
+				t.Fatalf("expected 1 request, got %d", n)
+			}
+		})
+	}
+}
+
+func TestRateLimitTransportForbidden(t *testing.T) {
+	clock := time.Now()
+
+	body := `{"message":"Resource not accessible by integration"}`
+	ts := httptest.NewServer(&rateLimitServer{handler: func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "100")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, body)
+	}})
+	defer ts.Close()
+
+	resp, err := get(t, newRateLimitTransport(ts, &clock), ts.URL, "forbidden")
+	if err != nil {
+		t.Fatalf("expected the forbidden response, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	// The body is still readable by the error validators.
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusForbidden || string(data) != body {
+		t.Fatalf("unexpected response: %d %s", resp.StatusCode, data)
+	}
+}
+
+func TestRateLimitTransportShortWait(t *testing.T) {
+	srv := &rateLimitServer{handler: func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	rt := &rateLimitTransport{RoundTripper: ts.Client().Transport, now: time.Now}
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL, nil)
+	req.Header.Set("Authorization", "Bearer short")
+	storeRateLimit(rateLimitKey(req), &rateLimit{remaining: 0, reset: time.Now().Add(50 * time.Millisecond)}, time.Now())
+
+	// Resets within rateLimitMaxDelay are waited for.
+	start := time.Now()
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if d := time.Since(start); d < 50*time.Millisecond {
+		t.Fatalf("expected the request to wait for the reset, waited %s", d)
+	}
+}
+
+func TestStoreRateLimitEviction(t *testing.T) {
+	now := time.Now()
+
+	storeRateLimit("evict.test|idle", &rateLimit{remaining: -1}, now)
+	storeRateLimit("evict.test|blocked", &rateLimit{remaining: 0, reset: now.Add(2 * rateLimitIdleTTL)}, now)
+
+	// Idle states are evicted once their reset is past.
+	storeRateLimit("evict.test|other", &rateLimit{remaining: -1}, now.Add(rateLimitIdleTTL+time.Minute))
+
+	if lookupRateLimit("evict.test|idle") != nil {
+		t.Error("expected the idle rate limit to be evicted")
+	}
+	if lookupRateLimit("evict.test|blocked") == nil {
+		t.Error("expected the blocked rate limit to be kept until its reset")
+	}
+}
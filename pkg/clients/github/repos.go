@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"time"
 
 	"github.com/carlmjohnson/requests"
 	"github.com/krateoplatformops/provider-github/apis/repo/v1alpha1"
@@ -17,90 +18,348 @@ type RepoService struct {
 	client       *http.Client
 	apiUrl       string
 	apiExtraPath string
-	token        string
 }
 
 // newRepoService returns a new RepoService.
-func newRepoService(httpClient *http.Client, apiUrl, extraPath, token string) *RepoService {
+func newRepoService(httpClient *http.Client, apiUrl, extraPath string) *RepoService {
 	return &RepoService{
 		client:       httpClient,
 		apiUrl:       apiUrl,
 		apiExtraPath: extraPath,
-		token:        token,
 	}
 }
 
-func (s *RepoService) Create(opts *v1alpha1.RepoParams) error {
-	ok, err := s.isOrg(opts.Org)
+// Create creates a new repository for the organization or the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#create-an-organization-repository
+func (s *RepoService) Create(ctx context.Context, opts *v1alpha1.RepoParams) error {
+	if opts.FromTemplate != nil && opts.FromFork != nil {
+		return fmt.Errorf("fromTemplate and fromFork are mutually exclusive")
+	}
+
+	if opts.FromTemplate != nil {
+		return s.createFromTemplate(ctx, opts)
+	}
+
+	ok, err := s.isOrg(ctx, opts.Org)
 	if err != nil {
 		return err
 	}
 
+	if opts.FromFork != nil {
+		return s.createFork(ctx, opts, ok)
+	}
+
 	pt := path.Join(s.apiExtraPath, "/user/repos")
 	if ok {
 		pt = path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/repos", opts.Org))
 	}
 
-	githubError := &GithubError{}
+	body := map[string]interface{}{
+		"name":      opts.Name,
+		"auto_init": helpers.BoolValueOrDefault(opts.Initialize, true),
+	}
+	if opts.Visibility == nil {
+		body["private"] = helpers.BoolValueOrDefault(opts.Private, true)
+	}
+	if opts.GitignoreTemplate != nil {
+		body["gitignore_template"] = *opts.GitignoreTemplate
+	}
+	if opts.LicenseTemplate != nil {
+		body["license_template"] = *opts.LicenseTemplate
+	}
 
-	err = requests.URL(s.apiUrl).Path(pt).
+	return requests.URL(s.apiUrl).Path(pt).
 		Client(s.client).
 		Method(http.MethodPost).
-		Header("Authorization", fmt.Sprintf("token %s", s.token)).
-		BodyJSON(map[string]interface{}{
-			"name":      opts.Name,
-			"private":   opts.Private,
-			"auto_init": helpers.BoolValueOrDefault(opts.Initialize, true),
-		}).
-		AddValidator(ErrorJSON(githubError, 201)).
-		Fetch(context.Background())
+		BodyJSON(repoSettings(opts, body)).
+		AddValidator(ErrorJSON(&GithubError{}, 201)).
+		Fetch(ctx)
+}
+
+// createFromTemplate creates a new repository using a template repository;
+// the content of the repository is populated asynchronously.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#create-a-repository-using-a-template
+func (s *RepoService) createFromTemplate(ctx context.Context, opts *v1alpha1.RepoParams) error {
+	tpl := opts.FromTemplate
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/generate", tpl.Owner, tpl.Name))
+
+	private := helpers.BoolValueOrDefault(opts.Private, true)
+	if opts.Visibility != nil {
+		private = *opts.Visibility != "public"
+	}
+
+	body := map[string]interface{}{
+		"owner":                opts.Org,
+		"name":                 opts.Name,
+		"private":              private,
+		"include_all_branches": helpers.BoolValue(tpl.IncludeAllBranches),
+	}
+	if opts.Description != nil {
+		body["description"] = *opts.Description
+	}
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPost).
+		BodyJSON(body).
+		AddValidator(ErrorJSON(&GithubError{}, 201)).
+		Fetch(ctx)
+}
+
+// createFork creates a fork of a repository into the organization (if isOrg)
+// or the authenticated user account; the fork is created asynchronously.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/forks#create-a-fork
+func (s *RepoService) createFork(ctx context.Context, opts *v1alpha1.RepoParams, isOrg bool) error {
+	src := opts.FromFork
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/forks", src.Owner, src.Name))
+
+	body := map[string]interface{}{
+		"name":                opts.Name,
+		"default_branch_only": helpers.BoolValue(src.DefaultBranchOnly),
+	}
+	if isOrg {
+		body["organization"] = opts.Org
+	}
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPost).
+		BodyJSON(body).
+		AddValidator(ErrorJSON(&GithubError{}, 202)).
+		Fetch(ctx)
+}
+
+// IsEmpty reports whether the repository has no commits yet,
+// i.e. while the content from a template is being populated.
+//
+// GitHub API docs: https://docs.github.com/en/rest/commits/commits#list-commits
+func (s *RepoService) IsEmpty(ctx context.Context, owner, name string) (bool, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/commits", owner, name))
+
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		Param("per_page", "1").
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
 	if err != nil {
-		var gerr *GithubError
-		if errors.As(err, &gerr) {
-			return fmt.Errorf(gerr.Error())
+		// GitHub answers 409 Conflict if the repository is empty.
+		if errors.Is(err, ErrConflict) {
+			return true, nil
 		}
-		return err
+
+		return false, err
 	}
 
-	return nil
+	return false, nil
+}
+
+// Repository represents a GitHub repository.
+type Repository struct {
+	ID                       int64                `json:"id"`
+	NodeID                   string               `json:"node_id"`
+	Name                     string               `json:"name"`
+	FullName                 string               `json:"full_name"`
+	HtmlURL                  string               `json:"html_url"`
+	CloneURL                 string               `json:"clone_url"`
+	SshURL                   string               `json:"ssh_url"`
+	DefaultBranch            string               `json:"default_branch"`
+	Private                  bool                 `json:"private"`
+	Visibility               string               `json:"visibility"`
+	Archived                 bool                 `json:"archived"`
+	Fork                     bool                 `json:"fork"`
+	Size                     int64                `json:"size"`
+	PushedAt                 *time.Time           `json:"pushed_at"`
+	OpenIssuesCount          int64                `json:"open_issues_count"`
+	Description              string               `json:"description"`
+	Homepage                 string               `json:"homepage"`
+	HasIssues                bool                 `json:"has_issues"`
+	HasWiki                  bool                 `json:"has_wiki"`
+	HasProjects              bool                 `json:"has_projects"`
+	HasDiscussions           bool                 `json:"has_discussions"`
+	AllowMergeCommit         bool                 `json:"allow_merge_commit"`
+	AllowSquashMerge         bool                 `json:"allow_squash_merge"`
+	AllowRebaseMerge         bool                 `json:"allow_rebase_merge"`
+	SquashMergeCommitTitle   string               `json:"squash_merge_commit_title"`
+	SquashMergeCommitMessage string               `json:"squash_merge_commit_message"`
+	DeleteBranchOnMerge      bool                 `json:"delete_branch_on_merge"`
+	AllowAutoMerge           bool                 `json:"allow_auto_merge"`
+	AllowUpdateBranch        bool                 `json:"allow_update_branch"`
+	WebCommitSignoffRequired bool                 `json:"web_commit_signoff_required"`
+	IsTemplate               bool                 `json:"is_template"`
+	Topics                   []string             `json:"topics"`
+	SecurityAndAnalysis      *SecurityAndAnalysis `json:"security_and_analysis,omitempty"`
+	Parent                   *RepositoryRef       `json:"parent,omitempty"`
+	Source                   *RepositoryRef       `json:"source,omitempty"`
 }
 
-// Get fetches a repository.
+// RepositoryRef is the summary of a related repository (i.e. the parent of a fork).
+type RepositoryRef struct {
+	ID       int64  `json:"id"`
+	FullName string `json:"full_name"`
+}
+
+// Get fetches a repository; returns nil if the repository does not exist.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-repository
-func (s *RepoService) Exists(opts *v1alpha1.RepoParams) (bool, error) {
-	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s", opts.Org, opts.Name))
+func (s *RepoService) Get(ctx context.Context, owner, name string) (*Repository, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s", owner, name))
+
+	return s.get(ctx, pt)
+}
+
+// GetByID fetches a repository by its unique identifier, which is stable across
+// renames and transfers; returns nil if the repository does not exist.
+func (s *RepoService) GetByID(ctx context.Context, id int64) (*Repository, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repositories/%d", id))
+
+	return s.get(ctx, pt)
+}
 
+func (s *RepoService) get(ctx context.Context, pt string) (*Repository, error) {
+	res := &Repository{}
 	err := requests.URL(s.apiUrl).Path(pt).
 		Client(s.client).
 		Method(http.MethodGet).
-		Header("Authorization", fmt.Sprintf("token %s", s.token)).
-		CheckStatus(200).
-		Fetch(context.Background())
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(res).
+		Fetch(ctx)
 	if err != nil {
-		if requests.HasStatusErr(err, 404) {
-			return false, nil
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
 		}
 
-		return false, err
+		return nil, err
 	}
 
-	return true, nil
+	return res, nil
+}
+
+// Update edits the mutable settings of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#update-a-repository
+func (s *RepoService) Update(ctx context.Context, owner, name string, opts *v1alpha1.RepoParams) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s", owner, name))
+
+	body := repoSettings(opts, map[string]interface{}{})
+	if sa := securityAndAnalysis(opts.SecurityAndAnalysis); len(sa) > 0 {
+		body["security_and_analysis"] = sa
+	}
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPatch).
+		BodyJSON(body).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
+}
+
+// ReplaceTopics replaces all the topics of the repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#replace-all-repository-topics
+func (s *RepoService) ReplaceTopics(ctx context.Context, owner, name string, topics []string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/topics", owner, name))
+
+	if topics == nil {
+		topics = []string{}
+	}
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPut).
+		BodyJSON(map[string]interface{}{
+			"names": topics,
+		}).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
+}
+
+// Archive makes the repository read-only.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#update-a-repository
+func (s *RepoService) Archive(ctx context.Context, owner, name string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s", owner, name))
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPatch).
+		BodyJSON(map[string]interface{}{
+			"archived": true,
+		}).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
+}
+
+// Rename changes the name of the repository; GitHub
+// redirects the requests made using the old name.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#update-a-repository
+func (s *RepoService) Rename(ctx context.Context, owner, name, newName string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s", owner, name))
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPatch).
+		BodyJSON(map[string]interface{}{
+			"name": newName,
+		}).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
+}
+
+// SetDefaultBranch changes the default branch of the repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#update-a-repository
+func (s *RepoService) SetDefaultBranch(ctx context.Context, owner, name, branch string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s", owner, name))
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPatch).
+		BodyJSON(map[string]interface{}{
+			"default_branch": branch,
+		}).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
+}
+
+// Transfer moves the repository to another user or organization;
+// the transfer is completed asynchronously.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#transfer-a-repository
+func (s *RepoService) Transfer(ctx context.Context, owner, name, newOwner string, teamIDs []int64) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/transfer", owner, name))
+
+	body := map[string]interface{}{
+		"new_owner": newOwner,
+	}
+	if len(teamIDs) > 0 {
+		body["team_ids"] = teamIDs
+	}
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPost).
+		BodyJSON(body).
+		AddValidator(ErrorJSON(&GithubError{}, 202)).
+		Fetch(ctx)
 }
 
 // Deleting a repository requires admin access. If OAuth is used, the delete_repo scope is required.
 // https://docs.github.com/en/rest/repos/repos#get-a-repository
-func (s *RepoService) Delete(opts *v1alpha1.RepoParams) error {
-	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s", opts.Org, opts.Name))
+func (s *RepoService) Delete(ctx context.Context, owner, name string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s", owner, name))
 
 	err := requests.URL(s.apiUrl).Path(pt).
 		Client(s.client).
 		Method(http.MethodDelete).
-		Header("Authorization", fmt.Sprintf("token %s", s.token)).
-		CheckStatus(204).
-		Fetch(context.Background())
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
 	if err != nil {
-		if requests.HasStatusErr(err, 404) {
+		if errors.Is(err, ErrNotFound) {
 			return nil
 		}
 
@@ -110,16 +369,15 @@ func (s *RepoService) Delete(opts *v1alpha1.RepoParams) error {
 	return nil
 }
 
-func (s *RepoService) isOrg(owner string) (bool, error) {
+func (s *RepoService) isOrg(ctx context.Context, owner string) (bool, error) {
 	pt := path.Join(s.apiExtraPath, fmt.Sprintf("/orgs/%s", owner))
 	err := requests.URL(s.apiUrl).Path(pt).
 		Client(s.client).
 		Method(http.MethodGet).
-		Header("Authorization", fmt.Sprintf("token %s", s.token)).
-		CheckStatus(200).
-		Fetch(context.Background())
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
 	if err != nil {
-		if requests.HasStatusErr(err, 404) {
+		if errors.Is(err, ErrNotFound) {
 			return false, nil
 		}
 
@@ -128,3 +386,44 @@ func (s *RepoService) isOrg(owner string) (bool, error) {
 
 	return true, nil
 }
+
+// repoSettings adds to body the repository settings that are set in opts.
+func repoSettings(opts *v1alpha1.RepoParams, body map[string]interface{}) map[string]interface{} {
+	if opts.Visibility != nil {
+		body["visibility"] = *opts.Visibility
+	} else if opts.Private != nil {
+		body["private"] = *opts.Private
+	}
+
+	for k, v := range map[string]*string{
+		"description":                 opts.Description,
+		"homepage":                    opts.Homepage,
+		"squash_merge_commit_title":   opts.SquashMergeCommitTitle,
+		"squash_merge_commit_message": opts.SquashMergeCommitMessage,
+	} {
+		if v != nil {
+			body[k] = *v
+		}
+	}
+
+	for k, v := range map[string]*bool{
+		"has_issues":                  opts.HasIssues,
+		"has_wiki":                    opts.HasWiki,
+		"has_projects":                opts.HasProjects,
+		"has_discussions":             opts.HasDiscussions,
+		"allow_merge_commit":          opts.AllowMergeCommit,
+		"allow_squash_merge":          opts.AllowSquashMerge,
+		"allow_rebase_merge":          opts.AllowRebaseMerge,
+		"delete_branch_on_merge":      opts.DeleteBranchOnMerge,
+		"allow_auto_merge":            opts.AllowAutoMerge,
+		"allow_update_branch":         opts.AllowUpdateBranch,
+		"web_commit_signoff_required": opts.WebCommitSignoffRequired,
+		"is_template":                 opts.IsTemplate,
+	} {
+		if v != nil {
+			body[k] = *v
+		}
+	}
+
+	return body
+}
@@ -0,0 +1,128 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/krateoplatformops/provider-github/apis/repo/v1alpha1"
+	"github.com/krateoplatformops/provider-github/pkg/helpers"
+)
+
+// recordBody returns a test server answering the creation requests
+// with the status code and recording the last request body.
+func recordBody(t *testing.T, body *map[string]interface{}) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/orgs/acme":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPost:
+			*body = map[string]interface{}{}
+			if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+				t.Errorf("cannot decode the request body: %v", err)
+			}
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestCreateBody(t *testing.T) {
+	cases := map[string]struct {
+		opts        v1alpha1.RepoParams
+		wantPrivate interface{}
+		wantInit    bool
+	}{
+		"Defaults": {
+			opts:        v1alpha1.RepoParams{Org: "acme", Name: "demo"},
+			wantPrivate: true,
+			wantInit:    true,
+		},
+		"Public": {
+			opts:        v1alpha1.RepoParams{Org: "acme", Name: "demo", Private: helpers.BoolPtr(false), Initialize: helpers.BoolPtr(false)},
+			wantPrivate: false,
+			wantInit:    false,
+		},
+		"Visibility": {
+			opts:        v1alpha1.RepoParams{Org: "acme", Name: "demo", Visibility: helpers.StringPtr("internal")},
+			wantPrivate: nil,
+			wantInit:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			body := map[string]interface{}{}
+			ts := recordBody(t, &body)
+			defer ts.Close()
+
+			cli, err := NewClient(ClientOpts{ApiURL: ts.URL, Token: "token", HttpClient: ts.Client()})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := cli.Repos().Create(context.Background(), &tc.opts); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := body["private"]; got != tc.wantPrivate {
+				t.Errorf("private: want %v, got %v", tc.wantPrivate, got)
+			}
+			if got := body["auto_init"]; got != tc.wantInit {
+				t.Errorf("auto_init: want %v, got %v", tc.wantInit, got)
+			}
+		})
+	}
+}
+
+func TestCreateFromTemplateBody(t *testing.T) {
+	tpl := &v1alpha1.RepoTemplate{Owner: "acme", Name: "template"}
+
+	cases := map[string]struct {
+		opts        v1alpha1.RepoParams
+		wantPrivate bool
+	}{
+		"Defaults": {
+			opts:        v1alpha1.RepoParams{Org: "acme", Name: "demo", FromTemplate: tpl},
+			wantPrivate: true,
+		},
+		"Public": {
+			opts:        v1alpha1.RepoParams{Org: "acme", Name: "demo", FromTemplate: tpl, Private: helpers.BoolPtr(false)},
+			wantPrivate: false,
+		},
+		"VisibilityPublic": {
+			opts:        v1alpha1.RepoParams{Org: "acme", Name: "demo", FromTemplate: tpl, Visibility: helpers.StringPtr("public")},
+			wantPrivate: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			body := map[string]interface{}{}
+			ts := recordBody(t, &body)
+			defer ts.Close()
+
+			cli, err := NewClient(ClientOpts{ApiURL: ts.URL, Token: "token", HttpClient: ts.Client()})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := cli.Repos().Create(context.Background(), &tc.opts); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := body["private"]; got != tc.wantPrivate {
+				t.Errorf("private: want %v, got %v", tc.wantPrivate, got)
+			}
+			if got := body["owner"]; got != "acme" {
+				t.Errorf("owner: want acme, got %v", got)
+			}
+		})
+	}
+}
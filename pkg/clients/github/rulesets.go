@@ -0,0 +1,303 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/carlmjohnson/requests"
+	"github.com/krateoplatformops/provider-github/apis/ruleset/v1alpha1"
+	"github.com/krateoplatformops/provider-github/pkg/helpers"
+)
+
+// RulesetService provides methods for managing repository and organization rulesets.
+type RulesetService struct {
+	client       *http.Client
+	apiUrl       string
+	apiExtraPath string
+}
+
+// newRulesetService returns a new RulesetService.
+func newRulesetService(httpClient *http.Client, apiUrl, extraPath string) *RulesetService {
+	return &RulesetService{
+		client:       httpClient,
+		apiUrl:       apiUrl,
+		apiExtraPath: extraPath,
+	}
+}
+
+// Ruleset represents a repository or organization ruleset.
+type Ruleset struct {
+	ID           int64                `json:"id,omitempty"`
+	NodeID       string               `json:"node_id,omitempty"`
+	Name         string               `json:"name"`
+	Target       string               `json:"target"`
+	SourceType   string               `json:"source_type,omitempty"`
+	Enforcement  string               `json:"enforcement"`
+	BypassActors []RulesetBypassActor `json:"bypass_actors"`
+	Conditions   *RulesetConditions   `json:"conditions,omitempty"`
+	Rules        []RulesetRule        `json:"rules"`
+	Links        *struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"_links,omitempty"`
+}
+
+// RulesetBypassActor represents an actor that can bypass a ruleset.
+type RulesetBypassActor struct {
+	ActorID    *int64 `json:"actor_id"`
+	ActorType  string `json:"actor_type"`
+	BypassMode string `json:"bypass_mode"`
+}
+
+// RulesetConditions represents the refs and the repositories a ruleset applies to.
+type RulesetConditions struct {
+	RefName        *RulesetPatterns `json:"ref_name,omitempty"`
+	RepositoryName *RulesetPatterns `json:"repository_name,omitempty"`
+}
+
+// RulesetPatterns represents the patterns included and excluded by a condition.
+type RulesetPatterns struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// RulesetRule represents a rule of a ruleset.
+type RulesetRule struct {
+	Type       string                 `json:"type"`
+	Parameters *RulesetRuleParameters `json:"parameters,omitempty"`
+}
+
+// RulesetRuleParameters holds the parameters of all the rule types; each
+// rule type sets only its own.
+type RulesetRuleParameters struct {
+	// pull_request
+	RequiredApprovingReviewCount   *int  `json:"required_approving_review_count,omitempty"`
+	DismissStaleReviewsOnPush      *bool `json:"dismiss_stale_reviews_on_push,omitempty"`
+	RequireCodeOwnerReview         *bool `json:"require_code_owner_review,omitempty"`
+	RequireLastPushApproval        *bool `json:"require_last_push_approval,omitempty"`
+	RequiredReviewThreadResolution *bool `json:"required_review_thread_resolution,omitempty"`
+
+	// required_status_checks
+	// A pointer, so that an empty list of checks is sent.
+	RequiredStatusChecks             *[]RulesetStatusCheck `json:"required_status_checks,omitempty"`
+	StrictRequiredStatusChecksPolicy *bool                 `json:"strict_required_status_checks_policy,omitempty"`
+
+	// commit_message_pattern
+	Name     *string `json:"name,omitempty"`
+	Negate   *bool   `json:"negate,omitempty"`
+	Operator *string `json:"operator,omitempty"`
+	Pattern  *string `json:"pattern,omitempty"`
+
+	// required_deployments
+	RequiredDeploymentEnvironments []string `json:"required_deployment_environments,omitempty"`
+
+	// file_path_restriction
+	RestrictedFilePaths []string `json:"restricted_file_paths,omitempty"`
+}
+
+// RulesetStatusCheck represents a status check required by a ruleset.
+type RulesetStatusCheck struct {
+	Context       string `json:"context"`
+	IntegrationID *int64 `json:"integration_id,omitempty"`
+}
+
+// Get fetches a ruleset of a repository, or of an organization if repo is empty;
+// returns nil if the ruleset does not exist.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/rules#get-a-repository-ruleset
+func (s *RulesetService) Get(ctx context.Context, org, repo string, id int64) (*Ruleset, error) {
+	pt := path.Join(s.rulesetsPath(org, repo), fmt.Sprintf("%d", id))
+
+	res := &Ruleset{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(res).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Create creates a ruleset and returns it.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/rules#create-a-repository-ruleset
+func (s *RulesetService) Create(ctx context.Context, opts *v1alpha1.RulesetParams) (*Ruleset, error) {
+	pt := s.rulesetsPath(opts.Org, helpers.StringValue(opts.Repo))
+
+	res := &Ruleset{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPost).
+		BodyJSON(RulesetFromParams(opts)).
+		AddValidator(ErrorJSON(&GithubError{}, 201)).
+		ToJSON(res).
+		Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Update replaces the settings and the rules of a ruleset.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/rules#update-a-repository-ruleset
+func (s *RulesetService) Update(ctx context.Context, id int64, opts *v1alpha1.RulesetParams) error {
+	pt := path.Join(s.rulesetsPath(opts.Org, helpers.StringValue(opts.Repo)), fmt.Sprintf("%d", id))
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPut).
+		BodyJSON(RulesetFromParams(opts)).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
+}
+
+// Delete deletes a ruleset.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/rules#delete-a-repository-ruleset
+func (s *RulesetService) Delete(ctx context.Context, org, repo string, id int64) error {
+	pt := path.Join(s.rulesetsPath(org, repo), fmt.Sprintf("%d", id))
+
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodDelete).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// rulesetsPath returns the path of the rulesets of the repository, or of the organization if repo is empty.
+func (s *RulesetService) rulesetsPath(org, repo string) string {
+	if repo == "" {
+		return path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/rulesets", org))
+	}
+	return path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/rulesets", org, repo))
+}
+
+// RulesetFromParams returns the ruleset described by the managed resource parameters,
+// with the defaults applied by GitHub, so that it can be compared with the observed one.
+func RulesetFromParams(opts *v1alpha1.RulesetParams) *Ruleset {
+	res := &Ruleset{
+		Name:         opts.Name,
+		Target:       helpers.StringValue(helpers.StringOrDefault(opts.Target, "branch")),
+		Enforcement:  helpers.StringValue(helpers.StringOrDefault(opts.Enforcement, "active")),
+		BypassActors: []RulesetBypassActor{},
+		Rules:        []RulesetRule{},
+	}
+
+	for _, a := range opts.BypassActors {
+		res.BypassActors = append(res.BypassActors, RulesetBypassActor{
+			ActorID:    a.ActorId,
+			ActorType:  a.ActorType,
+			BypassMode: helpers.StringValue(helpers.StringOrDefault(a.BypassMode, "always")),
+		})
+	}
+
+	if c := opts.Conditions; c != nil {
+		res.Conditions = &RulesetConditions{
+			RefName:        rulesetPatterns(c.RefName),
+			RepositoryName: rulesetPatterns(c.RepositoryName),
+		}
+	}
+
+	rules := opts.Rules
+
+	if pr := rules.PullRequest; pr != nil {
+		count := helpers.IntPtrValue(pr.RequiredApprovingReviewCount, 0)
+		res.Rules = append(res.Rules, RulesetRule{
+			Type: "pull_request",
+			Parameters: &RulesetRuleParameters{
+				RequiredApprovingReviewCount:   &count,
+				DismissStaleReviewsOnPush:      helpers.BoolPtr(helpers.BoolValue(pr.DismissStaleReviewsOnPush)),
+				RequireCodeOwnerReview:         helpers.BoolPtr(helpers.BoolValue(pr.RequireCodeOwnerReview)),
+				RequireLastPushApproval:        helpers.BoolPtr(helpers.BoolValue(pr.RequireLastPushApproval)),
+				RequiredReviewThreadResolution: helpers.BoolPtr(helpers.BoolValue(pr.RequiredReviewThreadResolution)),
+			},
+		})
+	}
+
+	if sc := rules.RequiredStatusChecks; sc != nil {
+		checks := make([]RulesetStatusCheck, 0, len(sc.Checks))
+		for _, c := range sc.Checks {
+			checks = append(checks, RulesetStatusCheck{Context: c.Context, IntegrationID: c.IntegrationId})
+		}
+		res.Rules = append(res.Rules, RulesetRule{
+			Type: "required_status_checks",
+			Parameters: &RulesetRuleParameters{
+				RequiredStatusChecks:             &checks,
+				StrictRequiredStatusChecksPolicy: helpers.BoolPtr(helpers.BoolValue(sc.Strict)),
+			},
+		})
+	}
+
+	if helpers.BoolValue(rules.RequiredSignatures) {
+		res.Rules = append(res.Rules, RulesetRule{Type: "required_signatures"})
+	}
+
+	if helpers.BoolValue(rules.NonFastForward) {
+		res.Rules = append(res.Rules, RulesetRule{Type: "non_fast_forward"})
+	}
+
+	if cm := rules.CommitMessagePattern; cm != nil {
+		res.Rules = append(res.Rules, RulesetRule{
+			Type: "commit_message_pattern",
+			Parameters: &RulesetRuleParameters{
+				Name:     cm.Name,
+				Negate:   helpers.BoolPtr(helpers.BoolValue(cm.Negate)),
+				Operator: helpers.StringPtr(cm.Operator),
+				Pattern:  helpers.StringPtr(cm.Pattern),
+			},
+		})
+	}
+
+	if len(rules.RequiredDeployments) > 0 {
+		res.Rules = append(res.Rules, RulesetRule{
+			Type: "required_deployments",
+			Parameters: &RulesetRuleParameters{
+				RequiredDeploymentEnvironments: rules.RequiredDeployments,
+			},
+		})
+	}
+
+	if len(rules.RestrictedFilePaths) > 0 {
+		res.Rules = append(res.Rules, RulesetRule{
+			Type: "file_path_restriction",
+			Parameters: &RulesetRuleParameters{
+				RestrictedFilePaths: rules.RestrictedFilePaths,
+			},
+		})
+	}
+
+	return res
+}
+
+func rulesetPatterns(p *v1alpha1.RulesetPatterns) *RulesetPatterns {
+	if p == nil {
+		return nil
+	}
+	return &RulesetPatterns{
+		Include: stringsOrEmpty(p.Include),
+		Exclude: stringsOrEmpty(p.Exclude),
+	}
+}
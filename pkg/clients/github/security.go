@@ -0,0 +1,149 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/carlmjohnson/requests"
+	"github.com/krateoplatformops/provider-github/apis/repo/v1alpha1"
+)
+
+// SecurityAndAnalysis represents the security and analysis features of a repository;
+// it is returned only to the users with admin access to the repository.
+type SecurityAndAnalysis struct {
+	AdvancedSecurity             *SecurityFeature `json:"advanced_security,omitempty"`
+	SecretScanning               *SecurityFeature `json:"secret_scanning,omitempty"`
+	SecretScanningPushProtection *SecurityFeature `json:"secret_scanning_push_protection,omitempty"`
+	DependabotSecurityUpdates    *SecurityFeature `json:"dependabot_security_updates,omitempty"`
+}
+
+// SecurityFeature is the status of a security feature (enabled or disabled).
+type SecurityFeature struct {
+	Status string `json:"status"`
+}
+
+// Enabled reports whether the feature is enabled.
+func (f *SecurityFeature) Enabled() bool {
+	return f != nil && f.Status == "enabled"
+}
+
+// EnabledPtr reports whether the feature is enabled; returns nil if the feature is not returned.
+func (f *SecurityFeature) EnabledPtr() *bool {
+	if f == nil {
+		return nil
+	}
+	res := f.Enabled()
+	return &res
+}
+
+// VulnerabilityAlertsEnabled reports whether Dependabot alerts are enabled for the repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#check-if-vulnerability-alerts-are-enabled-for-a-repository
+func (s *RepoService) VulnerabilityAlertsEnabled(ctx context.Context, owner, name string) (bool, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/vulnerability-alerts", owner, name))
+
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SetVulnerabilityAlerts enables or disables Dependabot alerts for the repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#enable-vulnerability-alerts
+func (s *RepoService) SetVulnerabilityAlerts(ctx context.Context, owner, name string, enabled bool) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/vulnerability-alerts", owner, name))
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(toggleMethod(enabled)).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+}
+
+// AutomatedSecurityFixesEnabled reports whether Dependabot security updates are enabled for the repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#check-if-automated-security-fixes-are-enabled-for-a-repository
+func (s *RepoService) AutomatedSecurityFixesEnabled(ctx context.Context, owner, name string) (bool, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/automated-security-fixes", owner, name))
+
+	res := struct {
+		Enabled bool `json:"enabled"`
+	}{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(&res).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return res.Enabled, nil
+}
+
+// SetAutomatedSecurityFixes enables or disables Dependabot security updates for the repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#enable-automated-security-fixes
+func (s *RepoService) SetAutomatedSecurityFixes(ctx context.Context, owner, name string, enabled bool) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("repos/%s/%s/automated-security-fixes", owner, name))
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(toggleMethod(enabled)).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+}
+
+// toggleMethod returns the method enabling (PUT) or disabling (DELETE) a feature.
+func toggleMethod(enabled bool) string {
+	if enabled {
+		return http.MethodPut
+	}
+	return http.MethodDelete
+}
+
+// securityAndAnalysis returns the security_and_analysis object of the repository
+// update request; vulnerability alerts and Dependabot security updates are toggled
+// through their own endpoints.
+func securityAndAnalysis(opts *v1alpha1.RepoSecurityAndAnalysis) map[string]interface{} {
+	res := map[string]interface{}{}
+	if opts == nil {
+		return res
+	}
+
+	for k, v := range map[string]*bool{
+		"advanced_security":               opts.AdvancedSecurity,
+		"secret_scanning":                 opts.SecretScanning,
+		"secret_scanning_push_protection": opts.SecretScanningPushProtection,
+	} {
+		if v == nil {
+			continue
+		}
+		status := "disabled"
+		if *v {
+			status = "enabled"
+		}
+		res[k] = map[string]string{"status": status}
+	}
+
+	return res
+}
@@ -0,0 +1,390 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/carlmjohnson/requests"
+	"github.com/krateoplatformops/provider-github/apis/org/v1alpha1"
+)
+
+const (
+	// teamMembersPerPage is the page size used to list the team members.
+	teamMembersPerPage = 100
+)
+
+// TeamService provides methods for managing organization teams.
+type TeamService struct {
+	client       *http.Client
+	apiUrl       string
+	apiExtraPath string
+}
+
+// newTeamService returns a new TeamService.
+func newTeamService(httpClient *http.Client, apiUrl, extraPath string) *TeamService {
+	return &TeamService{
+		client:       httpClient,
+		apiUrl:       apiUrl,
+		apiExtraPath: extraPath,
+	}
+}
+
+// Team represents an organization team.
+type Team struct {
+	ID                  int64    `json:"id"`
+	NodeID              string   `json:"node_id"`
+	Name                string   `json:"name"`
+	Slug                string   `json:"slug"`
+	Description         string   `json:"description"`
+	Privacy             string   `json:"privacy"`
+	NotificationSetting string   `json:"notification_setting"`
+	HtmlURL             string   `json:"html_url"`
+	Parent              *TeamRef `json:"parent,omitempty"`
+}
+
+// TeamRef is the summary of a related team (i.e. the parent team).
+type TeamRef struct {
+	ID   int64  `json:"id"`
+	Slug string `json:"slug"`
+}
+
+// Get fetches a team by slug; returns nil if the team does not exist.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/teams#get-a-team-by-name
+func (s *TeamService) Get(ctx context.Context, org, slug string) (*Team, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams/%s", org, slug))
+
+	return s.get(ctx, pt)
+}
+
+// GetByID fetches a team by the organization and team ids, which do not
+// change when the team is renamed; returns nil if the team does not exist.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/teams#get-a-team-by-name
+func (s *TeamService) GetByID(ctx context.Context, orgID, teamID int64) (*Team, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("organizations/%d/team/%d", orgID, teamID))
+
+	return s.get(ctx, pt)
+}
+
+func (s *TeamService) get(ctx context.Context, pt string) (*Team, error) {
+	res := &Team{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(res).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Create creates a team and returns it; parentID is zero for top level teams.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/teams#create-a-team
+func (s *TeamService) Create(ctx context.Context, opts *v1alpha1.TeamParams, parentID int64) (*Team, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams", opts.Org))
+
+	body := teamSettings(opts, parentID)
+	if len(opts.Maintainers) > 0 {
+		body["maintainers"] = opts.Maintainers
+	}
+
+	res := &Team{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPost).
+		BodyJSON(body).
+		AddValidator(ErrorJSON(&GithubError{}, 201)).
+		ToJSON(res).
+		Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Update edits the settings of a team and returns it; renaming the team changes its slug.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/teams#update-a-team
+func (s *TeamService) Update(ctx context.Context, slug string, opts *v1alpha1.TeamParams, parentID int64) (*Team, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams/%s", opts.Org, slug))
+
+	res := &Team{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPatch).
+		BodyJSON(teamSettings(opts, parentID)).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(res).
+		Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Delete deletes a team and all its child teams.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/teams#delete-a-team
+func (s *TeamService) Delete(ctx context.Context, org, slug string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams/%s", org, slug))
+
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodDelete).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// ListMembers returns the logins of the team members having the role (member, maintainer or all),
+// including the members of the child teams; the users invited to the team who have not accepted yet are not listed.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/members#list-team-members
+func (s *TeamService) ListMembers(ctx context.Context, org, slug, role string) ([]string, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams/%s/members", org, slug))
+
+	res := []string{}
+	for page := 1; ; page++ {
+		users := []struct {
+			Login string `json:"login"`
+		}{}
+		err := requests.URL(s.apiUrl).Path(pt).
+			Param("role", role).
+			Param("per_page", strconv.Itoa(teamMembersPerPage)).
+			Param("page", strconv.Itoa(page)).
+			Client(s.client).
+			Method(http.MethodGet).
+			AddValidator(ErrorJSON(&GithubError{}, 200)).
+			ToJSON(&users).
+			Fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, u := range users {
+			res = append(res, u.Login)
+		}
+
+		if len(users) < teamMembersPerPage {
+			return res, nil
+		}
+	}
+}
+
+// ListChildTeams returns the slugs of the teams whose parent is the team.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/teams#list-child-teams
+func (s *TeamService) ListChildTeams(ctx context.Context, org, slug string) ([]string, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams/%s/teams", org, slug))
+
+	res := []string{}
+	for page := 1; ; page++ {
+		teams := []TeamRef{}
+		err := requests.URL(s.apiUrl).Path(pt).
+			Param("per_page", strconv.Itoa(teamMembersPerPage)).
+			Param("page", strconv.Itoa(page)).
+			Client(s.client).
+			Method(http.MethodGet).
+			AddValidator(ErrorJSON(&GithubError{}, 200)).
+			ToJSON(&teams).
+			Fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range teams {
+			res = append(res, t.Slug)
+		}
+
+		if len(teams) < teamMembersPerPage {
+			return res, nil
+		}
+	}
+}
+
+// TeamMembership represents the membership of a user to a team.
+type TeamMembership struct {
+	Role  string `json:"role"`
+	State string `json:"state"`
+}
+
+// GetMembership fetches the membership of a user to the team, including the pending
+// invitations (state pending); returns nil if the user is neither a member nor invited.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/members#get-team-membership-for-a-user
+func (s *TeamService) GetMembership(ctx context.Context, org, slug, user string) (*TeamMembership, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", org, slug, user))
+
+	res := &TeamMembership{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(res).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetMembership adds a user to the team, or changes its role (member or maintainer);
+// users that are not organization members are invited.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/members#add-or-update-team-membership-for-a-user
+func (s *TeamService) SetMembership(ctx context.Context, org, slug, user, role string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", org, slug, user))
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPut).
+		BodyJSON(map[string]interface{}{
+			"role": role,
+		}).
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		Fetch(ctx)
+}
+
+// RemoveMembership removes a user from the team.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/members#remove-team-membership-for-a-user
+func (s *TeamService) RemoveMembership(ctx context.Context, org, slug, user string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", org, slug, user))
+
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodDelete).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// GetRepoPermission returns the role of the team on the repository (i.e. read, write
+// or a custom role), including the access inherited from a parent team, which is
+// not told apart by GitHub; returns "" if the team has no access.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/teams#check-team-permissions-for-a-repository
+func (s *TeamService) GetRepoPermission(ctx context.Context, org, slug, owner, repo string) (string, error) {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams/%s/repos/%s/%s", org, slug, owner, repo))
+
+	res := struct {
+		RoleName string `json:"role_name"`
+	}{}
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodGet).
+		Accept("application/vnd.github.v3.repository+json").
+		AddValidator(ErrorJSON(&GithubError{}, 200)).
+		ToJSON(&res).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return res.RoleName, nil
+}
+
+// SetRepoPermission grants the team access to the repository, or changes the permission.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/teams#add-or-update-team-repository-permissions
+func (s *TeamService) SetRepoPermission(ctx context.Context, org, slug, owner, repo, permission string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams/%s/repos/%s/%s", org, slug, owner, repo))
+
+	return requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodPut).
+		BodyJSON(map[string]interface{}{
+			"permission": permission,
+		}).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+}
+
+// RemoveRepo revokes the team access to the repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/teams#remove-a-repository-from-a-team
+func (s *TeamService) RemoveRepo(ctx context.Context, org, slug, owner, repo string) error {
+	pt := path.Join(s.apiExtraPath, fmt.Sprintf("orgs/%s/teams/%s/repos/%s/%s", org, slug, owner, repo))
+
+	err := requests.URL(s.apiUrl).Path(pt).
+		Client(s.client).
+		Method(http.MethodDelete).
+		AddValidator(ErrorJSON(&GithubError{}, 204)).
+		Fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// teamSettings returns the request body of the team create or update;
+// a negative parentID removes the parent team, zero leaves it untouched.
+func teamSettings(opts *v1alpha1.TeamParams, parentID int64) map[string]interface{} {
+	body := map[string]interface{}{
+		"name": opts.Name,
+	}
+
+	for k, v := range map[string]*string{
+		"description":          opts.Description,
+		"privacy":              opts.Privacy,
+		"notification_setting": opts.NotificationSetting,
+	} {
+		if v != nil {
+			body[k] = *v
+		}
+	}
+
+	switch {
+	case parentID > 0:
+		body["parent_team_id"] = parentID
+	case parentID < 0:
+		body["parent_team_id"] = nil
+	}
+
+	return body
+}
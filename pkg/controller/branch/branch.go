@@ -0,0 +1,300 @@
+package branch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	gitv1alpha1 "github.com/krateoplatformops/provider-github/apis/git/v1alpha1"
+	githubv1alpha1 "github.com/krateoplatformops/provider-github/apis/v1alpha1"
+	"github.com/krateoplatformops/provider-github/pkg/clients"
+	"github.com/krateoplatformops/provider-github/pkg/clients/github"
+	"github.com/krateoplatformops/provider-github/pkg/controller/ratelimit"
+	"github.com/krateoplatformops/provider-github/pkg/helpers"
+)
+
+const (
+	errNotBranch = "managed resource is not a branch custom resource"
+)
+
+// Reasons of the events recorded on state transitions.
+const (
+	reasonCreated        = "BranchCreated"
+	reasonDriftDetected  = "BranchDriftDetected"
+	reasonDriftCorrected = "BranchDriftCorrected"
+	reasonDeleted        = "BranchDeleted"
+	reasonRetained       = "BranchRetained"
+)
+
+// Setup adds a controller that reconciles Branch managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(gitv1alpha1.BranchGroupKind)
+
+	log := o.Logger.WithValues("controller", name)
+
+	recorder := mgr.GetEventRecorderFor(name)
+
+	rl := ratelimit.NewTracker()
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(gitv1alpha1.BranchGroupVersionKind),
+		managed.WithExternalConnecter(rl.NewConnecter(&connector{
+			kube:     mgr.GetClient(),
+			log:      log,
+			recorder: recorder,
+		})),
+		// The external-name is set to org/repo/branch by the
+		// external client, never to the resource name.
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(recorder)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&gitv1alpha1.Branch{}).
+		Complete(ratelimiter.NewReconciler(name, rl.NewReconciler(r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube     client.Client
+	log      logging.Logger
+	recorder record.EventRecorder
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*gitv1alpha1.Branch)
+	if !ok {
+		return nil, errors.New(errNotBranch)
+	}
+
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	ghCli, err := github.NewClient(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:  c.kube,
+		log:   c.log,
+		ghCli: ghCli,
+		rec:   c.recorder,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube  client.Client
+	log   logging.Logger
+	ghCli *github.Client
+	rec   record.EventRecorder
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*gitv1alpha1.Branch)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBranch)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	ref, err := e.ghCli.Git().GetBranchRef(ctx, spec.Org, spec.Repo, spec.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if ref == nil {
+		e.log.Debug("Branch does not exists", "org", spec.Org, "repo", spec.Repo, "name", spec.Name)
+
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	repo, err := e.ghCli.Repos().Get(ctx, spec.Org, spec.Repo)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if repo == nil {
+		return managed.ExternalObservation{}, fmt.Errorf("repo '%s/%s' not found", spec.Org, spec.Repo)
+	}
+
+	lateInitialized := false
+	if en := externalName(spec); meta.GetExternalName(cr) != en {
+		meta.SetExternalName(cr, en)
+		lateInitialized = true
+	}
+
+	isDefault := repo.DefaultBranch == spec.Name
+
+	// GitHub does not delete the default branch, so it is left in place.
+	if meta.WasDeleted(cr) && isDefault {
+		e.log.Debug("Branch is the default branch, not deleted", "org", spec.Org, "repo", spec.Repo, "name", spec.Name)
+		e.rec.Eventf(cr, corev1.EventTypeWarning, reasonRetained, "Branch '%s' not deleted: it is the default branch of the repo", externalName(spec))
+
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	cr.Status.AtProvider = gitv1alpha1.BranchObservation{
+		Ref:     helpers.StringPtr(ref.Ref),
+		Sha:     helpers.StringPtr(ref.Object.SHA),
+		Default: helpers.BoolPtr(isDefault),
+	}
+	cr.SetConditions(xpv1.Available())
+
+	drift := []string{}
+	if helpers.BoolValue(spec.Default) && !isDefault {
+		drift = append(drift, fmt.Sprintf("default: desired %q, observed %q", spec.Name, repo.DefaultBranch))
+	}
+
+	if len(drift) == 0 {
+		if prev := cr.GetCondition(githubv1alpha1.TypeDrift); prev.Status == corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftCorrected, "Branch '%s' drift corrected: %s", externalName(spec), prev.Message)
+		}
+		cr.SetConditions(githubv1alpha1.InSync())
+	} else {
+		details := strings.Join(drift, "; ")
+		if cr.GetCondition(githubv1alpha1.TypeDrift).Status != corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftDetected, "Branch '%s' drift detected: %s", externalName(spec), details)
+		}
+		cr.SetConditions(githubv1alpha1.DriftDetected(details))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        len(drift) == 0,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*gitv1alpha1.Branch)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBranch)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	sha, err := e.sourceSha(ctx, spec)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	ref, err := e.ghCli.Git().CreateBranchRef(ctx, spec.Org, spec.Repo, spec.Name, sha)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if helpers.BoolValue(spec.Default) {
+		if err := e.ghCli.Repos().SetDefaultBranch(ctx, spec.Org, spec.Repo, spec.Name); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	meta.SetExternalName(cr, externalName(spec))
+	e.log.Debug("Branch created", "org", spec.Org, "repo", spec.Repo, "name", spec.Name, "sha", ref.Object.SHA)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonCreated, "Branch '%s' created at %s", externalName(spec), ref.Object.SHA)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*gitv1alpha1.Branch)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBranch)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	if helpers.BoolValue(spec.Default) && !helpers.BoolValue(cr.Status.AtProvider.Default) {
+		if err := e.ghCli.Repos().SetDefaultBranch(ctx, spec.Org, spec.Repo, spec.Name); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	e.log.Debug("Branch updated", "org", spec.Org, "repo", spec.Repo, "name", spec.Name)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*gitv1alpha1.Branch)
+	if !ok {
+		return errors.New(errNotBranch)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	if err := e.ghCli.Git().DeleteBranchRef(ctx, spec.Org, spec.Repo, spec.Name); err != nil {
+		return err
+	}
+	e.log.Debug("Branch deleted", "org", spec.Org, "repo", spec.Repo, "name", spec.Name)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDeleted, "Branch '%s' deleted", externalName(spec))
+
+	return nil
+}
+
+// sourceSha returns the commit the branch starts from: the source
+// commit, or the head of the source branch or of the default branch.
+func (e *external) sourceSha(ctx context.Context, spec *gitv1alpha1.BranchParams) (string, error) {
+	if sha := helpers.StringValue(spec.SourceSha); sha != "" {
+		return sha, nil
+	}
+
+	source := helpers.StringValue(spec.SourceBranch)
+	if source == "" {
+		repo, err := e.ghCli.Repos().Get(ctx, spec.Org, spec.Repo)
+		if err != nil {
+			return "", err
+		}
+		if repo == nil {
+			return "", fmt.Errorf("repo '%s/%s' not found", spec.Org, spec.Repo)
+		}
+		source = repo.DefaultBranch
+	}
+
+	ref, err := e.ghCli.Git().GetBranchRef(ctx, spec.Org, spec.Repo, source)
+	if err != nil {
+		return "", err
+	}
+	if ref == nil {
+		return "", fmt.Errorf("source branch '%s' not found in repo '%s/%s'", source, spec.Org, spec.Repo)
+	}
+
+	return ref.Object.SHA, nil
+}
+
+// externalName returns the external-name of the branch (owner/repo/branch).
+func externalName(spec *gitv1alpha1.BranchParams) string {
+	return fmt.Sprintf("%s/%s/%s", spec.Org, spec.Repo, spec.Name)
+}
@@ -0,0 +1,407 @@
+package branchprotection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	branchprotectionv1alpha1 "github.com/krateoplatformops/provider-github/apis/branchprotection/v1alpha1"
+	githubv1alpha1 "github.com/krateoplatformops/provider-github/apis/v1alpha1"
+	"github.com/krateoplatformops/provider-github/pkg/clients"
+	"github.com/krateoplatformops/provider-github/pkg/clients/github"
+	"github.com/krateoplatformops/provider-github/pkg/controller/ratelimit"
+	"github.com/krateoplatformops/provider-github/pkg/helpers"
+)
+
+const (
+	errNotBranchProtection = "managed resource is not a branch protection custom resource"
+)
+
+// Reasons of the events recorded on state transitions.
+const (
+	reasonCreated        = "BranchProtectionCreated"
+	reasonDriftDetected  = "BranchProtectionDriftDetected"
+	reasonDriftCorrected = "BranchProtectionDriftCorrected"
+	reasonDeleted        = "BranchProtectionDeleted"
+)
+
+// Setup adds a controller that reconciles BranchProtection managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(branchprotectionv1alpha1.BranchProtectionGroupKind)
+
+	log := o.Logger.WithValues("controller", name)
+
+	recorder := mgr.GetEventRecorderFor(name)
+
+	rl := ratelimit.NewTracker()
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(branchprotectionv1alpha1.BranchProtectionGroupVersionKind),
+		managed.WithExternalConnecter(rl.NewConnecter(&connector{
+			kube:     mgr.GetClient(),
+			log:      log,
+			recorder: recorder,
+		})),
+		// The external-name is set to org/repo/branch by the
+		// external client, never to the resource name.
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(recorder)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&branchprotectionv1alpha1.BranchProtection{}).
+		Complete(ratelimiter.NewReconciler(name, rl.NewReconciler(r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube     client.Client
+	log      logging.Logger
+	recorder record.EventRecorder
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*branchprotectionv1alpha1.BranchProtection)
+	if !ok {
+		return nil, errors.New(errNotBranchProtection)
+	}
+
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	ghCli, err := github.NewClient(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:  c.kube,
+		log:   c.log,
+		ghCli: ghCli,
+		rec:   c.recorder,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube  client.Client
+	log   logging.Logger
+	ghCli *github.Client
+	rec   record.EventRecorder
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*branchprotectionv1alpha1.BranchProtection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBranchProtection)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	prot, err := e.ghCli.Branches().GetProtection(ctx, spec.Org, spec.Repo, spec.Branch)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if prot == nil {
+		e.log.Debug("Branch protection does not exists", "org", spec.Org, "repo", spec.Repo, "branch", spec.Branch)
+
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	lateInitialized := false
+	if en := externalName(spec); meta.GetExternalName(cr) != en {
+		meta.SetExternalName(cr, en)
+		lateInitialized = true
+	}
+
+	cr.Status.AtProvider = generateObservation(prot)
+	cr.SetConditions(xpv1.Available())
+
+	drift := diff(&spec.BranchProtectionRules, &cr.Status.AtProvider.BranchProtectionRules)
+	if len(drift) == 0 {
+		if prev := cr.GetCondition(githubv1alpha1.TypeDrift); prev.Status == corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftCorrected, "Branch protection '%s' drift corrected: %s", externalName(spec), prev.Message)
+		}
+		cr.SetConditions(githubv1alpha1.InSync())
+	} else {
+		details := strings.Join(drift, "; ")
+		if cr.GetCondition(githubv1alpha1.TypeDrift).Status != corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftDetected, "Branch protection '%s' drift detected: %s", externalName(spec), details)
+		}
+		cr.SetConditions(githubv1alpha1.DriftDetected(details))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        len(drift) == 0,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*branchprotectionv1alpha1.BranchProtection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBranchProtection)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	err := e.ghCli.Branches().UpdateProtection(ctx, spec)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if helpers.BoolValue(spec.RequiredSignatures) {
+		err := e.ghCli.Branches().SetRequiredSignatures(ctx, spec.Org, spec.Repo, spec.Branch, true)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	meta.SetExternalName(cr, externalName(spec))
+	e.log.Debug("Branch protection created", "org", spec.Org, "repo", spec.Repo, "branch", spec.Branch)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonCreated, "Branch protection '%s' created", externalName(spec))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*branchprotectionv1alpha1.BranchProtection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBranchProtection)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	err := e.ghCli.Branches().UpdateProtection(ctx, spec)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if want := helpers.BoolValue(spec.RequiredSignatures); want != helpers.BoolValue(cr.Status.AtProvider.RequiredSignatures) {
+		err := e.ghCli.Branches().SetRequiredSignatures(ctx, spec.Org, spec.Repo, spec.Branch, want)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	e.log.Debug("Branch protection updated", "org", spec.Org, "repo", spec.Repo, "branch", spec.Branch)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*branchprotectionv1alpha1.BranchProtection)
+	if !ok {
+		return errors.New(errNotBranchProtection)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	if err := e.ghCli.Branches().DeleteProtection(ctx, spec.Org, spec.Repo, spec.Branch); err != nil {
+		return err
+	}
+	e.log.Debug("Branch protection deleted", "org", spec.Org, "repo", spec.Repo, "branch", spec.Branch)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDeleted, "Branch protection '%s' deleted", externalName(spec))
+
+	return nil
+}
+
+// externalName returns the external-name of the branch protection (owner/repo/branch).
+func externalName(spec *branchprotectionv1alpha1.BranchProtectionParams) string {
+	return fmt.Sprintf("%s/%s/%s", spec.Org, spec.Repo, spec.Branch)
+}
+
+// diff returns the differences between the desired protection rules and the observed
+// ones; since the protection is replaced as a whole, the rules not set must be disabled.
+func diff(desired, observed *branchprotectionv1alpha1.BranchProtectionRules) []string {
+	res := []string{}
+
+	switch want, got := desired.RequiredStatusChecks, observed.RequiredStatusChecks; {
+	case want == nil && got != nil:
+		res = append(res, "requiredStatusChecks: desired disabled, observed enabled")
+	case want != nil && got == nil:
+		res = append(res, "requiredStatusChecks: desired enabled, observed disabled")
+	case want != nil:
+		res = diffBool(res, "requiredStatusChecks.strict", want.Strict, got.Strict)
+		if !sameChecks(want.Checks, got.Checks) {
+			res = append(res, fmt.Sprintf("requiredStatusChecks.checks: desired %v, observed %v", checkNames(want.Checks), checkNames(got.Checks)))
+		}
+	}
+
+	switch want, got := desired.RequiredPullRequestReviews, observed.RequiredPullRequestReviews; {
+	case want == nil && got != nil:
+		res = append(res, "requiredPullRequestReviews: desired disabled, observed enabled")
+	case want != nil && got == nil:
+		res = append(res, "requiredPullRequestReviews: desired enabled, observed disabled")
+	case want != nil:
+		if w, g := helpers.IntPtrValue(want.RequiredApprovingReviewCount, 1), helpers.IntPtrValue(got.RequiredApprovingReviewCount, 1); w != g {
+			res = append(res, fmt.Sprintf("requiredPullRequestReviews.requiredApprovingReviewCount: desired %d, observed %d", w, g))
+		}
+		res = diffBool(res, "requiredPullRequestReviews.requireCodeOwnerReviews", want.RequireCodeOwnerReviews, got.RequireCodeOwnerReviews)
+		res = diffBool(res, "requiredPullRequestReviews.dismissStaleReviews", want.DismissStaleReviews, got.DismissStaleReviews)
+		res = diffBool(res, "requiredPullRequestReviews.requireLastPushApproval", want.RequireLastPushApproval, got.RequireLastPushApproval)
+	}
+
+	switch want, got := desired.Restrictions, observed.Restrictions; {
+	case want == nil && got != nil:
+		res = append(res, "restrictions: desired disabled, observed enabled")
+	case want != nil && got == nil:
+		res = append(res, "restrictions: desired enabled, observed disabled")
+	case want != nil:
+		res = diffSet(res, "restrictions.users", want.Users, got.Users)
+		res = diffSet(res, "restrictions.teams", want.Teams, got.Teams)
+		res = diffSet(res, "restrictions.apps", want.Apps, got.Apps)
+	}
+
+	res = diffBool(res, "enforceAdmins", desired.EnforceAdmins, observed.EnforceAdmins)
+	res = diffBool(res, "requiredLinearHistory", desired.RequiredLinearHistory, observed.RequiredLinearHistory)
+	res = diffBool(res, "allowForcePushes", desired.AllowForcePushes, observed.AllowForcePushes)
+	res = diffBool(res, "allowDeletions", desired.AllowDeletions, observed.AllowDeletions)
+	res = diffBool(res, "requiredConversationResolution", desired.RequiredConversationResolution, observed.RequiredConversationResolution)
+	res = diffBool(res, "requiredSignatures", desired.RequiredSignatures, observed.RequiredSignatures)
+
+	return res
+}
+
+// diffBool appends to res the difference of a rule, if any; unset rules are disabled.
+func diffBool(res []string, field string, desired, observed *bool) []string {
+	if helpers.BoolValue(desired) == helpers.BoolValue(observed) {
+		return res
+	}
+	return append(res, fmt.Sprintf("%s: desired %t, observed %t", field, helpers.BoolValue(desired), helpers.BoolValue(observed)))
+}
+
+// diffSet appends to res the difference of a list, if any, ignoring order and case.
+func diffSet(res []string, field string, desired, observed []string) []string {
+	a, b := normalize(desired), normalize(observed)
+	if strings.Join(a, ",") == strings.Join(b, ",") {
+		return res
+	}
+	return append(res, fmt.Sprintf("%s: desired %v, observed %v", field, a, b))
+}
+
+// sameChecks reports whether the status checks are the same, ignoring order;
+// the app of a check is compared only when desired.
+func sameChecks(desired, observed []branchprotectionv1alpha1.StatusCheck) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+
+	apps := map[string]*int64{}
+	for _, c := range observed {
+		apps[c.Context] = c.AppId
+	}
+
+	for _, c := range desired {
+		app, ok := apps[c.Context]
+		if !ok {
+			return false
+		}
+		if c.AppId != nil && (app == nil || *app != *c.AppId) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkNames returns the sorted names of the status checks.
+func checkNames(checks []branchprotectionv1alpha1.StatusCheck) []string {
+	res := make([]string, 0, len(checks))
+	for _, c := range checks {
+		res = append(res, c.Context)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// normalize returns the sorted and lowercase values.
+func normalize(values []string) []string {
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		res = append(res, strings.ToLower(v))
+	}
+	sort.Strings(res)
+	return res
+}
+
+// generateObservation maps the observed protection to the status of the managed resource.
+func generateObservation(prot *github.BranchProtection) branchprotectionv1alpha1.BranchProtectionObservation {
+	res := branchprotectionv1alpha1.BranchProtectionObservation{
+		Url: helpers.StringPtr(prot.URL),
+		BranchProtectionRules: branchprotectionv1alpha1.BranchProtectionRules{
+			EnforceAdmins:                  helpers.BoolPtr(prot.EnforceAdmins.IsEnabled()),
+			RequiredLinearHistory:          helpers.BoolPtr(prot.RequiredLinearHistory.IsEnabled()),
+			AllowForcePushes:               helpers.BoolPtr(prot.AllowForcePushes.IsEnabled()),
+			AllowDeletions:                 helpers.BoolPtr(prot.AllowDeletions.IsEnabled()),
+			RequiredConversationResolution: helpers.BoolPtr(prot.RequiredConversationResolution.IsEnabled()),
+			RequiredSignatures:             helpers.BoolPtr(prot.RequiredSignatures.IsEnabled()),
+		},
+	}
+
+	if sc := prot.RequiredStatusChecks; sc != nil {
+		checks := make([]branchprotectionv1alpha1.StatusCheck, 0, len(sc.Checks))
+		for _, c := range sc.Checks {
+			checks = append(checks, branchprotectionv1alpha1.StatusCheck{Context: c.Context, AppId: c.AppID})
+		}
+		res.RequiredStatusChecks = &branchprotectionv1alpha1.RequiredStatusChecks{
+			Strict: helpers.BoolPtr(sc.Strict),
+			Checks: checks,
+		}
+	}
+
+	if rv := prot.RequiredPullRequestReviews; rv != nil {
+		count := rv.RequiredApprovingReviewCount
+		res.RequiredPullRequestReviews = &branchprotectionv1alpha1.RequiredPullRequestReviews{
+			RequiredApprovingReviewCount: &count,
+			RequireCodeOwnerReviews:      helpers.BoolPtr(rv.RequireCodeOwnerReviews),
+			DismissStaleReviews:          helpers.BoolPtr(rv.DismissStaleReviews),
+			RequireLastPushApproval:      helpers.BoolPtr(rv.RequireLastPushApproval),
+		}
+	}
+
+	if r := prot.Restrictions; r != nil {
+		res.Restrictions = &branchprotectionv1alpha1.BranchRestrictions{}
+		for _, u := range r.Users {
+			res.Restrictions.Users = append(res.Restrictions.Users, u.Login)
+		}
+		for _, t := range r.Teams {
+			res.Restrictions.Teams = append(res.Restrictions.Teams, t.Slug)
+		}
+		for _, a := range r.Apps {
+			res.Restrictions.Apps = append(res.Restrictions.Apps, a.Slug)
+		}
+	}
+
+	return res
+}
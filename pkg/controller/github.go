@@ -4,8 +4,14 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/krateoplatformops/provider-github/pkg/controller/branch"
+	"github.com/krateoplatformops/provider-github/pkg/controller/branchprotection"
 	"github.com/krateoplatformops/provider-github/pkg/controller/config"
+	"github.com/krateoplatformops/provider-github/pkg/controller/orgcustompropertyschema"
 	"github.com/krateoplatformops/provider-github/pkg/controller/repo"
+	"github.com/krateoplatformops/provider-github/pkg/controller/ruleset"
+	"github.com/krateoplatformops/provider-github/pkg/controller/team"
+	"github.com/krateoplatformops/provider-github/pkg/controller/teamrepository"
 )
 
 // Setup creates all controllers with the supplied logger and adds them to
@@ -14,6 +20,12 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		config.Setup,
 		repo.Setup,
+		orgcustompropertyschema.Setup,
+		branchprotection.Setup,
+		ruleset.Setup,
+		branch.Setup,
+		team.Setup,
+		teamrepository.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -0,0 +1,315 @@
+package orgcustompropertyschema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	orgv1alpha1 "github.com/krateoplatformops/provider-github/apis/org/v1alpha1"
+	githubv1alpha1 "github.com/krateoplatformops/provider-github/apis/v1alpha1"
+	"github.com/krateoplatformops/provider-github/pkg/clients"
+	"github.com/krateoplatformops/provider-github/pkg/clients/github"
+	"github.com/krateoplatformops/provider-github/pkg/controller/ratelimit"
+	"github.com/krateoplatformops/provider-github/pkg/helpers"
+)
+
+const (
+	errNotOrgCustomPropertySchema = "managed resource is not a org custom property schema custom resource"
+)
+
+// Reasons of the events recorded on state transitions.
+const (
+	reasonCreated        = "CustomPropertyCreated"
+	reasonDriftDetected  = "CustomPropertyDriftDetected"
+	reasonDriftCorrected = "CustomPropertyDriftCorrected"
+	reasonDeleted        = "CustomPropertyDeleted"
+)
+
+// Setup adds a controller that reconciles OrgCustomPropertySchema managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(orgv1alpha1.OrgCustomPropertySchemaGroupKind)
+
+	log := o.Logger.WithValues("controller", name)
+
+	recorder := mgr.GetEventRecorderFor(name)
+
+	rl := ratelimit.NewTracker()
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(orgv1alpha1.OrgCustomPropertySchemaGroupVersionKind),
+		managed.WithExternalConnecter(rl.NewConnecter(&connector{
+			kube:     mgr.GetClient(),
+			log:      log,
+			recorder: recorder,
+		})),
+		// The external-name is set to org/name by the
+		// external client, never to the resource name.
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(recorder)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&orgv1alpha1.OrgCustomPropertySchema{}).
+		Complete(ratelimiter.NewReconciler(name, rl.NewReconciler(r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube     client.Client
+	log      logging.Logger
+	recorder record.EventRecorder
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*orgv1alpha1.OrgCustomPropertySchema)
+	if !ok {
+		return nil, errors.New(errNotOrgCustomPropertySchema)
+	}
+
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	ghCli, err := github.NewClient(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:  c.kube,
+		log:   c.log,
+		ghCli: ghCli,
+		rec:   c.recorder,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube  client.Client
+	log   logging.Logger
+	ghCli *github.Client
+	rec   record.EventRecorder
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*orgv1alpha1.OrgCustomPropertySchema)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrgCustomPropertySchema)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	prop, err := e.ghCli.Orgs().GetCustomProperty(ctx, spec.Org, spec.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if prop == nil {
+		e.log.Debug("Custom property does not exists", "org", spec.Org, "name", spec.Name)
+
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	lateInitialized := false
+	if en := fmt.Sprintf("%s/%s", spec.Org, spec.Name); meta.GetExternalName(cr) != en {
+		meta.SetExternalName(cr, en)
+		lateInitialized = true
+	}
+
+	cr.Status.AtProvider = generateObservation(prop)
+	cr.SetConditions(xpv1.Available())
+
+	drift := diff(spec, prop)
+	if len(drift) == 0 {
+		if prev := cr.GetCondition(githubv1alpha1.TypeDrift); prev.Status == corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftCorrected, "Custom property '%s/%s' drift corrected: %s", spec.Org, spec.Name, prev.Message)
+		}
+		cr.SetConditions(githubv1alpha1.InSync())
+	} else {
+		details := strings.Join(drift, "; ")
+		if cr.GetCondition(githubv1alpha1.TypeDrift).Status != corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftDetected, "Custom property '%s/%s' drift detected: %s", spec.Org, spec.Name, details)
+		}
+		cr.SetConditions(githubv1alpha1.DriftDetected(details))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        len(drift) == 0,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*orgv1alpha1.OrgCustomPropertySchema)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrgCustomPropertySchema)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	err := e.ghCli.Orgs().CreateOrUpdateCustomProperty(ctx, spec)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, fmt.Sprintf("%s/%s", spec.Org, spec.Name))
+	e.log.Debug("Custom property created", "org", spec.Org, "name", spec.Name)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonCreated, "Custom property '%s/%s' created", spec.Org, spec.Name)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*orgv1alpha1.OrgCustomPropertySchema)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrgCustomPropertySchema)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	err := e.ghCli.Orgs().CreateOrUpdateCustomProperty(ctx, spec)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	e.log.Debug("Custom property updated", "org", spec.Org, "name", spec.Name)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*orgv1alpha1.OrgCustomPropertySchema)
+	if !ok {
+		return errors.New(errNotOrgCustomPropertySchema)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	if err := e.ghCli.Orgs().DeleteCustomProperty(ctx, spec.Org, spec.Name); err != nil {
+		return err
+	}
+	e.log.Debug("Custom property deleted", "org", spec.Org, "name", spec.Name)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDeleted, "Custom property '%s/%s' deleted", spec.Org, spec.Name)
+
+	return nil
+}
+
+// diff returns the differences between the desired
+// state and the observed custom property.
+func diff(spec *orgv1alpha1.OrgCustomPropertySchemaParams, prop *github.CustomProperty) []string {
+	res := []string{}
+
+	if spec.ValueType != prop.ValueType {
+		res = append(res, fmt.Sprintf("valueType: desired %q, observed %q", spec.ValueType, prop.ValueType))
+	}
+
+	if spec.Required != nil && *spec.Required != prop.Required {
+		res = append(res, fmt.Sprintf("required: desired %t, observed %t", *spec.Required, prop.Required))
+	}
+
+	if spec.ValueType == "multi_select" {
+		if spec.DefaultValues != nil && !sameValues(spec.DefaultValues, defaultValues(prop)) {
+			res = append(res, fmt.Sprintf("defaultValues: desired %q, observed %q", spec.DefaultValues, defaultValues(prop)))
+		}
+	} else if spec.DefaultValue != nil && *spec.DefaultValue != defaultValue(prop) {
+		res = append(res, fmt.Sprintf("defaultValue: desired %q, observed %q", *spec.DefaultValue, defaultValue(prop)))
+	}
+
+	if spec.Description != nil && *spec.Description != prop.Description {
+		res = append(res, fmt.Sprintf("description: desired %q, observed %q", *spec.Description, prop.Description))
+	}
+
+	if spec.AllowedValues != nil && !sameValues(spec.AllowedValues, prop.AllowedValues) {
+		res = append(res, fmt.Sprintf("allowedValues: desired %v, observed %v", spec.AllowedValues, prop.AllowedValues))
+	}
+
+	if spec.ValuesEditableBy != nil && *spec.ValuesEditableBy != prop.ValuesEditableBy {
+		res = append(res, fmt.Sprintf("valuesEditableBy: desired %q, observed %q", *spec.ValuesEditableBy, prop.ValuesEditableBy))
+	}
+
+	return res
+}
+
+// sameValues reports whether the allowed values are the same, ignoring order.
+func sameValues(desired, observed []string) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+
+	a := append([]string{}, desired...)
+	b := append([]string{}, observed...)
+	sort.Strings(a)
+	sort.Strings(b)
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// defaultValue returns the default value of the property, unless it is a list.
+func defaultValue(prop *github.CustomProperty) string {
+	if val, ok := prop.DefaultValue.(string); ok {
+		return val
+	}
+	return ""
+}
+
+// defaultValues returns the default values of a multi_select property.
+func defaultValues(prop *github.CustomProperty) []string {
+	val, ok := prop.DefaultValue.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	res := make([]string, 0, len(val))
+	for _, el := range val {
+		res = append(res, fmt.Sprint(el))
+	}
+	return res
+}
+
+// generateObservation maps the observed custom property to the status of the managed resource.
+func generateObservation(prop *github.CustomProperty) orgv1alpha1.OrgCustomPropertySchemaObservation {
+	res := orgv1alpha1.OrgCustomPropertySchemaObservation{
+		ValueType:     helpers.StringPtr(prop.ValueType),
+		Required:      helpers.BoolPtr(prop.Required),
+		AllowedValues: prop.AllowedValues,
+	}
+
+	if v := defaultValue(prop); v != "" {
+		res.DefaultValue = helpers.StringPtr(v)
+	}
+	res.DefaultValues = defaultValues(prop)
+
+	return res
+}
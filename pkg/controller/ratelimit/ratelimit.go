@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/krateoplatformops/provider-github/pkg/clients/github"
+)
+
+// A Tracker records the GitHub rate limit resets hit by managed resources,
+// so that they are requeued at the reset time instead of using the generic backoff.
+type Tracker struct {
+	mu     sync.Mutex
+	resets map[types.NamespacedName]time.Time
+}
+
+// NewTracker returns a new Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		resets: map[types.NamespacedName]time.Time{},
+	}
+}
+
+// Track records the reset time if err is a github.RateLimitError; err is returned unchanged.
+func (t *Tracker) Track(mg resource.Managed, err error) error {
+	var rle *github.RateLimitError
+	if !errors.As(err, &rle) {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.resets[types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetName()}] = rle.Reset
+
+	return err
+}
+
+// pop returns and forgets the reset time recorded for the request.
+func (t *Tracker) pop(nn types.NamespacedName) (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	res, ok := t.resets[nn]
+	delete(t.resets, nn)
+
+	return res, ok
+}
+
+// NewConnecter wraps the supplied ExternalConnecter so that the
+// rate limit errors of its ExternalClients are tracked.
+func (t *Tracker) NewConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		ec, err := c.Connect(ctx, mg)
+		if err != nil {
+			return nil, t.Track(mg, err)
+		}
+		return &external{ExternalClient: ec, tracker: t}, nil
+	})
+}
+
+// NewReconciler wraps the supplied Reconciler so that requests that
+// hit a rate limit are requeued at the rate limit reset time.
+func (t *Tracker) NewReconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		res, err := r.Reconcile(ctx, req)
+
+		reset, ok := t.pop(req.NamespacedName)
+		if !ok || err != nil {
+			return res, err
+		}
+
+		if d := time.Until(reset); d > 0 {
+			return reconcile.Result{RequeueAfter: d}, nil
+		}
+
+		return res, err
+	})
+}
+
+// external tracks the rate limit errors of the wrapped ExternalClient
+// and prefixes the GitHub API errors with their reason.
+type external struct {
+	managed.ExternalClient
+	tracker *Tracker
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	res, err := e.ExternalClient.Observe(ctx, mg)
+	return res, e.tracker.Track(mg, github.WithReason(err))
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	res, err := e.ExternalClient.Create(ctx, mg)
+	return res, e.tracker.Track(mg, github.WithReason(err))
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	res, err := e.ExternalClient.Update(ctx, mg)
+	return res, e.tracker.Track(mg, github.WithReason(err))
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	return e.tracker.Track(mg, github.WithReason(e.ExternalClient.Delete(ctx, mg)))
+}
@@ -3,9 +3,14 @@ package repo
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -15,18 +20,44 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	repov1alpha1 "github.com/krateoplatformops/provider-github/apis/repo/v1alpha1"
+	githubv1alpha1 "github.com/krateoplatformops/provider-github/apis/v1alpha1"
 	"github.com/krateoplatformops/provider-github/pkg/clients"
 	"github.com/krateoplatformops/provider-github/pkg/clients/github"
+	"github.com/krateoplatformops/provider-github/pkg/controller/ratelimit"
+	"github.com/krateoplatformops/provider-github/pkg/helpers"
 )
 
 const (
 	errNotRepo = "managed resource is not a repo custom resource"
 )
 
+// Reasons of the events recorded on state transitions.
+const (
+	reasonCreated         = "RepoCreated"
+	reasonAdopted         = "RepoAdopted"
+	reasonDriftDetected   = "RepoDriftDetected"
+	reasonDriftCorrected  = "RepoDriftCorrected"
+	reasonDeleted         = "RepoDeleted"
+	reasonArchived        = "RepoArchived"
+	reasonTransferred     = "RepoTransferred"
+	reasonTransferExpired = "RepoTransferExpired"
+	reasonRenamed         = "RepoRenamed"
+)
+
+const (
+	// asyncCreationGracePeriod is how long a created repository may be reported
+	// missing, since templates are generated and forks are created asynchronously.
+	asyncCreationGracePeriod = 5 * time.Minute
+	// pendingTransferTimeout is how long a transfer may wait to be accepted by
+	// the new owner; GitHub expires the transfer requests after a day.
+	pendingTransferTimeout = 24 * time.Hour
+)
+
 // Setup adds a controller that reconciles Token managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(repov1alpha1.RepoGroupKind)
@@ -39,13 +70,19 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 
 	recorder := mgr.GetEventRecorderFor(name)
 
+	rl := ratelimit.NewTracker()
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(repov1alpha1.RepoGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(rl.NewConnecter(&connector{
 			kube:     mgr.GetClient(),
 			log:      log,
 			recorder: recorder,
-		}),
+		})),
+		// The external-name is set to owner/repo by the
+		// external client, never to the resource name.
+		managed.WithInitializers(),
+		managed.WithCreationGracePeriod(asyncCreationGracePeriod),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(log),
 		managed.WithRecorder(event.NewAPIRecorder(recorder)))
@@ -54,7 +91,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&repov1alpha1.Repo{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(ratelimiter.NewReconciler(name, rl.NewReconciler(r), o.GlobalRateLimiter))
 }
 
 type connector struct {
@@ -74,10 +111,15 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
+	ghCli, err := github.NewClient(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	return &external{
 		kube:  c.kube,
 		log:   c.log,
-		ghCli: github.NewClient(*cfg),
+		ghCli: ghCli,
 		rec:   c.recorder,
 	}, nil
 }
@@ -98,28 +140,167 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	spec := cr.Spec.ForProvider.DeepCopy()
+	if err := validate(spec); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	owner, name := repoFullName(cr)
 
-	ok, err := e.ghCli.Repos().Exists(spec)
+	repo, err := e.ghCli.Repos().Get(ctx, owner, name)
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 
-	if ok {
-		e.log.Debug("Repo already exists", "org", spec.Org, "name", spec.Name)
-		e.rec.Eventf(cr, corev1.EventTypeNormal, "AlredyExists", "Repo '%s/%s' already exists", spec.Org, spec.Name)
+	// The repository may have been renamed or transferred, so
+	// look it up by the identifier observed the last time.
+	if repo == nil && cr.Status.AtProvider.Id != nil {
+		repo, err = e.ghCli.Repos().GetByID(ctx, *cr.Status.AtProvider.Id)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	if repo == nil {
+		e.log.Debug("Repo does not exists", "org", owner, "name", name)
+
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	if meta.WasDeleted(cr) && isReleased(spec, repo) {
+		e.log.Debug("Repo released by the deletion policy", "org", owner, "name", name)
 
-		cr.SetConditions(xpv1.Available())
 		return managed.ExternalObservation{
-			ResourceExists:   true,
+			ResourceExists:   false,
 			ResourceUpToDate: true,
 		}, nil
 	}
 
-	e.log.Debug("Repo does not exists", "org", spec.Org, "name", spec.Name)
+	e.log.Debug("Repo already exists", "org", owner, "name", name)
+
+	// Adopt the repository, or track it after a rename or transfer. The
+	// resources created before the external-name was set to owner/name
+	// carry the bare repository name, so they are migrated silently.
+	lateInitialized := false
+	if en := meta.GetExternalName(cr); en != repo.FullName {
+		if !strings.Contains(en, "/") && en != spec.Name {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonAdopted, "Repo '%s' adopted", repo.FullName)
+		}
+		meta.SetExternalName(cr, repo.FullName)
+		lateInitialized = true
+	}
+
+	// Keep track of the transfer to the desired owner until it is completed
+	// or expired; an expired transfer is reported as drift and requested again.
+	pending, since := cr.Status.AtProvider.PendingTransfer, cr.Status.AtProvider.PendingTransferSince
+	cr.Status.AtProvider = generateObservation(repo)
+	if pending != nil {
+		switch {
+		case strings.EqualFold(repoOwner(repo), *pending):
+			cr.SetConditions(githubv1alpha1.TransferCompleted())
+		case since != nil && time.Since(since.Time) > pendingTransferTimeout:
+			details := fmt.Sprintf("transfer to '%s' requested at %s not completed", *pending, since.UTC().Format(time.RFC3339))
+			e.rec.Eventf(cr, corev1.EventTypeWarning, reasonTransferExpired, "Repo '%s' %s", repo.FullName, details)
+			cr.SetConditions(githubv1alpha1.TransferExpired(details))
+		default:
+			if since == nil {
+				now := metav1.Now()
+				since = &now
+			}
+			cr.Status.AtProvider.PendingTransfer = pending
+			cr.Status.AtProvider.PendingTransferSince = since
+		}
+	}
+
+	// The content of a repository just created from a template or forked is
+	// populated asynchronously, so it is not available until the first commit;
+	// adopted repositories and those past the grace period may be empty.
+	if (spec.FromTemplate != nil || spec.FromFork != nil) && cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonAvailable &&
+		meta.ExternalCreateSucceededDuring(cr, asyncCreationGracePeriod) {
+		empty, err := e.ghCli.Repos().IsEmpty(ctx, owner, name)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+
+		if empty {
+			e.log.Debug("Repo content is being populated", "org", owner, "name", name)
+			cr.SetConditions(xpv1.Creating())
+
+			return managed.ExternalObservation{
+				ResourceExists:          true,
+				ResourceUpToDate:        true,
+				ResourceLateInitialized: lateInitialized,
+			}, nil
+		}
+	}
+
+	// The initial files are seeded by Update once the repository created by
+	// this resource is available; it becomes available when they all exist.
+	missing := []string{}
+	if seeding(cr) {
+		missing, err = e.missingFiles(ctx, owner, name, spec.InitialFiles)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	if len(missing) == 0 {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		e.log.Debug("Repo initial files are missing", "org", owner, "name", name, "paths", missing)
+		cr.SetConditions(xpv1.Creating())
+	}
+
+	drift := diff(spec, repo, cr.Status.AtProvider.PendingTransfer)
+
+	// Custom property values are only observed when managed by the resource.
+	if len(spec.CustomProperties) > 0 || len(spec.MultiSelectCustomProperties) > 0 {
+		values, err := e.ghCli.Repos().GetCustomPropertyValues(ctx, owner, name)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		cr.Status.AtProvider.CustomProperties = managedCustomProperties(spec.CustomProperties, values.Values)
+		cr.Status.AtProvider.MultiSelectCustomProperties = managedMultiSelectCustomProperties(spec.MultiSelectCustomProperties, values.MultiSelect)
+		drift = append(drift, diffCustomProperties(spec.CustomProperties, values.Values)...)
+		drift = append(drift, diffMultiSelectCustomProperties(spec.MultiSelectCustomProperties, values.MultiSelect)...)
+	}
+
+	// Security and analysis features are only observed when managed by the resource.
+	if sa := spec.SecurityAndAnalysis; sa != nil {
+		observed, err := e.observeSecurityAndAnalysis(ctx, owner, name, sa, repo)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		cr.Status.AtProvider.SecurityAndAnalysis = observed
+		drift = append(drift, diffSecurityAndAnalysis(sa, observed)...)
+
+		// GitHub returns the security and analysis settings only with admin access.
+		if unknown := unobservedSecurityAndAnalysis(sa, observed); len(unknown) > 0 {
+			cr.SetConditions(githubv1alpha1.PartiallyObservable(fmt.Sprintf(
+				"%s: not returned by GitHub, admin access to the repository is required", strings.Join(unknown, ", "))))
+		} else {
+			cr.SetConditions(githubv1alpha1.Observable())
+		}
+	}
+
+	if len(drift) == 0 {
+		if prev := cr.GetCondition(githubv1alpha1.TypeDrift); prev.Status == corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftCorrected, "Repo '%s' drift corrected: %s", repo.FullName, prev.Message)
+		}
+		cr.SetConditions(githubv1alpha1.InSync())
+	} else {
+		details := strings.Join(drift, "; ")
+		if cr.GetCondition(githubv1alpha1.TypeDrift).Status != corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftDetected, "Repo '%s' drift detected: %s", repo.FullName, details)
+		}
+		cr.SetConditions(githubv1alpha1.DriftDetected(details))
+	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   false,
-		ResourceUpToDate: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        len(drift) == 0 && len(missing) == 0,
+		ResourceLateInitialized: lateInitialized,
 	}, nil
 }
 
@@ -133,18 +314,92 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	spec := cr.Spec.ForProvider.DeepCopy()
 
-	err := e.ghCli.Repos().Create(spec)
+	err := e.ghCli.Repos().Create(ctx, spec)
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
+	meta.SetExternalName(cr, fmt.Sprintf("%s/%s", spec.Org, spec.Name))
 	e.log.Debug("Repo created", "org", spec.Org, "name", spec.Name)
-	e.rec.Eventf(cr, corev1.EventTypeNormal, "RepoCreated", "Repo '%s/%s' created", spec.Org, spec.Name)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonCreated, "Repo '%s/%s' created", spec.Org, spec.Name)
 
 	return managed.ExternalCreation{}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	return managed.ExternalUpdate{}, nil // noop
+	cr, ok := mg.(*repov1alpha1.Repo)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRepo)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+	owner, name := repoFullName(cr)
+
+	if seeding(cr) {
+		if err := e.seedFiles(ctx, owner, name, spec.InitialFiles); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	err := e.ghCli.Repos().Update(ctx, owner, name, spec)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if spec.Topics != nil && !sameTopics(spec.Topics, cr.Status.AtProvider.Topics) {
+		err := e.ghCli.Repos().ReplaceTopics(ctx, owner, name, normalizeTopics(spec.Topics))
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if len(diffCustomProperties(spec.CustomProperties, cr.Status.AtProvider.CustomProperties)) > 0 ||
+		len(diffMultiSelectCustomProperties(spec.MultiSelectCustomProperties, cr.Status.AtProvider.MultiSelectCustomProperties)) > 0 {
+		err := e.ghCli.Repos().UpdateCustomPropertyValues(ctx, owner, name, &github.CustomPropertyValues{
+			Values:      spec.CustomProperties,
+			MultiSelect: spec.MultiSelectCustomProperties,
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if sa := spec.SecurityAndAnalysis; sa != nil {
+		err := e.updateSecurityAndAnalysis(ctx, owner, name, sa, cr.Status.AtProvider.SecurityAndAnalysis)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	// The repository is located by id once renamed, since the
+	// external-name is updated by the next observation.
+	if name != spec.Name {
+		err := e.ghCli.Repos().Rename(ctx, owner, name, spec.Name)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+
+		e.log.Debug("Repo renamed", "org", owner, "name", name, "newName", spec.Name)
+		e.rec.Eventf(cr, corev1.EventTypeNormal, reasonRenamed, "Repo '%s/%s' renamed to '%s/%s'", owner, name, owner, spec.Name)
+		name = spec.Name
+	}
+
+	pending := cr.Status.AtProvider.PendingTransfer
+	if !strings.EqualFold(owner, spec.Org) && (pending == nil || !strings.EqualFold(*pending, spec.Org)) {
+		err := e.ghCli.Repos().Transfer(ctx, owner, name, spec.Org, spec.TransferTeamIds)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		now := metav1.Now()
+		cr.Status.AtProvider.PendingTransfer = helpers.StringPtr(spec.Org)
+		cr.Status.AtProvider.PendingTransferSince = &now
+		cr.SetConditions(githubv1alpha1.TransferPending(fmt.Sprintf("transfer to '%s' requested", spec.Org)))
+
+		e.log.Debug("Repo transfer requested", "org", owner, "name", name, "newOrg", spec.Org)
+		e.rec.Eventf(cr, corev1.EventTypeNormal, reasonTransferred, "Repo '%s/%s' transfer to '%s' requested", owner, name, spec.Org)
+	}
+	e.log.Debug("Repo updated", "org", owner, "name", name)
+
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -156,13 +411,471 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr.SetConditions(xpv1.Deleting())
 
 	spec := cr.Spec.ForProvider.DeepCopy()
+	owner, name := repoFullName(cr)
+
+	switch policy := onDeletePolicy(spec); policy {
+	case repov1alpha1.OnDeleteArchive:
+		if err := e.ghCli.Repos().Archive(ctx, owner, name); err != nil {
+			return err
+		}
+		e.log.Debug("Repo archived", "org", owner, "name", name)
+		e.rec.Eventf(cr, corev1.EventTypeNormal, reasonArchived, "Repo '%s/%s' archived", owner, name)
+
+	case repov1alpha1.OnDeleteRenameAndArchive:
+		// The suffix is derived from the deletion time, so that a repository
+		// already renamed by a failed attempt is not renamed again.
+		newName := name
+		if suffix := archivedSuffix(cr); !strings.HasSuffix(name, suffix) {
+			newName = name + suffix
+			if err := e.ghCli.Repos().Rename(ctx, owner, name, newName); err != nil {
+				return err
+			}
+		}
+		if err := e.ghCli.Repos().Archive(ctx, owner, newName); err != nil {
+			return err
+		}
+		e.log.Debug("Repo renamed and archived", "org", owner, "name", name, "newName", newName)
+		e.rec.Eventf(cr, corev1.EventTypeNormal, reasonArchived, "Repo '%s/%s' renamed to '%s' and archived", owner, name, newName)
+
+	case repov1alpha1.OnDeleteTransferToGraveyardOrg:
+		if err := validate(spec); err != nil {
+			return err
+		}
+		graveyard := *spec.GraveyardOrg
+		if pending := cr.Status.AtProvider.PendingTransfer; pending != nil && strings.EqualFold(*pending, graveyard) {
+			e.log.Debug("Repo transfer pending", "org", owner, "name", name, "newOrg", graveyard)
+			return nil
+		}
+		if err := e.ghCli.Repos().Transfer(ctx, owner, name, graveyard, nil); err != nil {
+			return err
+		}
+		now := metav1.Now()
+		cr.Status.AtProvider.PendingTransfer = helpers.StringPtr(graveyard)
+		cr.Status.AtProvider.PendingTransferSince = &now
+		cr.SetConditions(githubv1alpha1.TransferPending(fmt.Sprintf("transfer to '%s' requested", graveyard)))
+		e.log.Debug("Repo transfer requested", "org", owner, "name", name, "newOrg", graveyard)
+		e.rec.Eventf(cr, corev1.EventTypeNormal, reasonTransferred, "Repo '%s/%s' transfer to '%s' requested", owner, name, graveyard)
+
+	default:
+		if err := e.ghCli.Repos().Delete(ctx, owner, name); err != nil {
+			return err
+		}
+		e.log.Debug("Repo deleted", "org", owner, "name", name)
+		e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDeleted, "Repo '%s/%s' deleted", owner, name)
+	}
+
+	return nil
+}
+
+// validate checks the fields required by the deletion policy.
+func validate(spec *repov1alpha1.RepoParams) error {
+	if policy := onDeletePolicy(spec); policy == repov1alpha1.OnDeleteTransferToGraveyardOrg &&
+		helpers.StringValue(spec.GraveyardOrg) == "" {
+		return fmt.Errorf("graveyardOrg is required by the %s policy", policy)
+	}
+	return nil
+}
+
+// archivedSuffix returns the suffix of the name of the repository
+// renamed by the rename-and-archive policy.
+func archivedSuffix(cr *repov1alpha1.Repo) string {
+	t := time.Now()
+	if ts := cr.GetDeletionTimestamp(); ts != nil {
+		t = ts.Time
+	}
+	return fmt.Sprintf("-archived-%s", t.UTC().Format("20060102150405"))
+}
+
+// onDeletePolicy returns the deletion policy, defaulting to delete.
+func onDeletePolicy(spec *repov1alpha1.RepoParams) repov1alpha1.OnDeletePolicy {
+	if spec.OnDelete == nil {
+		return repov1alpha1.OnDeleteDelete
+	}
+	return *spec.OnDelete
+}
+
+// repoOwner returns the login of the repository owner.
+func repoOwner(repo *github.Repository) string {
+	return strings.Split(repo.FullName, "/")[0]
+}
+
+// isReleased reports whether the deletion policy has already been applied
+// to the repository, so that it is no longer managed by the resource.
+func isReleased(spec *repov1alpha1.RepoParams, repo *github.Repository) bool {
+	switch onDeletePolicy(spec) {
+	case repov1alpha1.OnDeleteArchive, repov1alpha1.OnDeleteRenameAndArchive:
+		return repo.Archived
+	case repov1alpha1.OnDeleteTransferToGraveyardOrg:
+		return spec.GraveyardOrg != nil && strings.EqualFold(repoOwner(repo), *spec.GraveyardOrg)
+	}
+	return false
+}
+
+// seeding reports whether the initial files of the repository created
+// by the resource are being seeded, that is until it is available.
+func seeding(cr *repov1alpha1.Repo) bool {
+	return len(cr.Spec.ForProvider.InitialFiles) > 0 &&
+		cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonAvailable &&
+		!meta.GetExternalCreateSucceeded(cr).IsZero()
+}
+
+// missingFiles returns the paths of the initial files missing from the repository.
+func (e *external) missingFiles(ctx context.Context, owner, name string, files []repov1alpha1.RepoInitialFile) ([]string, error) {
+	res := []string{}
+	for _, f := range files {
+		ok, err := e.ghCli.Repos().FileExists(ctx, owner, name, f.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			res = append(res, f.Path)
+		}
+	}
+	return res, nil
+}
 
-	err := e.ghCli.Repos().Delete(spec)
+// seedFiles commits the initial files missing from the repository.
+func (e *external) seedFiles(ctx context.Context, owner, name string, files []repov1alpha1.RepoInitialFile) error {
+	missing, err := e.missingFiles(ctx, owner, name, files)
 	if err != nil {
 		return err
 	}
-	e.log.Debug("Repo deleted", "org", spec.Org, "name", spec.Name)
-	e.rec.Eventf(cr, corev1.EventTypeNormal, "RepDeleted", "Repo '%s/%s' deleted", spec.Org, spec.Name)
+
+	for _, f := range files {
+		if !helpers.StringSliceContains(missing, f.Path) {
+			continue
+		}
+
+		ref := f.ConfigMapKeyRef
+		content, err := helpers.GetConfigMapValue(ctx, e.kube, ref.Namespace, ref.Name, ref.Key)
+		if err != nil {
+			return err
+		}
+
+		msg := helpers.StringValue(f.Message)
+		if msg == "" {
+			msg = fmt.Sprintf("Add %s", f.Path)
+		}
+
+		if err := e.ghCli.Repos().CreateFile(ctx, owner, name, f.Path, msg, content); err != nil {
+			return err
+		}
+		e.log.Debug("Repo initial file created", "org", owner, "name", name, "path", f.Path)
+	}
 
 	return nil
 }
+
+// observeSecurityAndAnalysis returns the state of the security and analysis features;
+// vulnerability alerts and Dependabot security updates are fetched only when desired.
+// The other features are left unset when GitHub does not return them.
+func (e *external) observeSecurityAndAnalysis(ctx context.Context, owner, name string,
+	desired *repov1alpha1.RepoSecurityAndAnalysis, repo *github.Repository) (*repov1alpha1.RepoSecurityAndAnalysis, error) {
+	res := &repov1alpha1.RepoSecurityAndAnalysis{}
+
+	if sa := repo.SecurityAndAnalysis; sa != nil {
+		res.AdvancedSecurity = sa.AdvancedSecurity.EnabledPtr()
+		res.SecretScanning = sa.SecretScanning.EnabledPtr()
+		res.SecretScanningPushProtection = sa.SecretScanningPushProtection.EnabledPtr()
+	}
+
+	if desired.VulnerabilityAlerts != nil || desired.DependabotSecurityUpdates != nil {
+		ok, err := e.ghCli.Repos().VulnerabilityAlertsEnabled(ctx, owner, name)
+		if err != nil {
+			return nil, err
+		}
+		res.VulnerabilityAlerts = helpers.BoolPtr(ok)
+	}
+
+	if desired.DependabotSecurityUpdates != nil {
+		ok, err := e.ghCli.Repos().AutomatedSecurityFixesEnabled(ctx, owner, name)
+		if err != nil {
+			return nil, err
+		}
+		res.DependabotSecurityUpdates = helpers.BoolPtr(ok)
+	}
+
+	return res, nil
+}
+
+// updateSecurityAndAnalysis toggles vulnerability alerts and Dependabot security updates;
+// the other features are updated by the repository update. Dependabot security updates
+// require vulnerability alerts, so these are enabled first and disabled last.
+func (e *external) updateSecurityAndAnalysis(ctx context.Context, owner, name string,
+	desired, observed *repov1alpha1.RepoSecurityAndAnalysis) error {
+	if observed == nil {
+		observed = &repov1alpha1.RepoSecurityAndAnalysis{}
+	}
+
+	alerts := desired.VulnerabilityAlerts
+	if alerts != nil && *alerts && !helpers.BoolValue(observed.VulnerabilityAlerts) {
+		if err := e.ghCli.Repos().SetVulnerabilityAlerts(ctx, owner, name, true); err != nil {
+			return err
+		}
+	}
+
+	fixes := desired.DependabotSecurityUpdates
+	if fixes != nil && *fixes != helpers.BoolValue(observed.DependabotSecurityUpdates) {
+		if err := e.ghCli.Repos().SetAutomatedSecurityFixes(ctx, owner, name, *fixes); err != nil {
+			return err
+		}
+	}
+
+	if alerts != nil && !*alerts && helpers.BoolValue(observed.VulnerabilityAlerts) {
+		if err := e.ghCli.Repos().SetVulnerabilityAlerts(ctx, owner, name, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffSecurityAndAnalysis returns the differences between the desired security
+// and analysis features and the observed ones; unobserved features are skipped.
+func diffSecurityAndAnalysis(desired, observed *repov1alpha1.RepoSecurityAndAnalysis) []string {
+	res := []string{}
+	for _, f := range securityFeatures(desired, observed) {
+		if f.observed != nil {
+			res = diffBool(res, "securityAndAnalysis."+f.name, f.desired, *f.observed)
+		}
+	}
+	return res
+}
+
+// unobservedSecurityAndAnalysis returns the desired security and analysis features that are not observed.
+func unobservedSecurityAndAnalysis(desired, observed *repov1alpha1.RepoSecurityAndAnalysis) []string {
+	res := []string{}
+	for _, f := range securityFeatures(desired, observed) {
+		if f.desired != nil && f.observed == nil {
+			res = append(res, "securityAndAnalysis."+f.name)
+		}
+	}
+	return res
+}
+
+// securityFeature pairs the desired and the observed state of a security and analysis feature.
+type securityFeature struct {
+	name     string
+	desired  *bool
+	observed *bool
+}
+
+// securityFeatures returns the desired and the observed state of the security and analysis features.
+func securityFeatures(desired, observed *repov1alpha1.RepoSecurityAndAnalysis) []securityFeature {
+	return []securityFeature{
+		{"advancedSecurity", desired.AdvancedSecurity, observed.AdvancedSecurity},
+		{"secretScanning", desired.SecretScanning, observed.SecretScanning},
+		{"secretScanningPushProtection", desired.SecretScanningPushProtection, observed.SecretScanningPushProtection},
+		{"dependabotSecurityUpdates", desired.DependabotSecurityUpdates, observed.DependabotSecurityUpdates},
+		{"vulnerabilityAlerts", desired.VulnerabilityAlerts, observed.VulnerabilityAlerts},
+	}
+}
+
+// repoFullName returns the owner and the name of the repository identified by
+// the external-name annotation (owner/repo), falling back to the spec.
+func repoFullName(cr *repov1alpha1.Repo) (string, string) {
+	parts := strings.Split(meta.GetExternalName(cr), "/")
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1]
+	}
+
+	return cr.Spec.ForProvider.Org, cr.Spec.ForProvider.Name
+}
+
+// diff returns the differences between every mutable field
+// of the desired state and the observed repository.
+func diff(spec *repov1alpha1.RepoParams, repo *github.Repository, pendingTransfer *string) []string {
+	res := []string{}
+
+	if owner := repoOwner(repo); !strings.EqualFold(owner, spec.Org) &&
+		(pendingTransfer == nil || !strings.EqualFold(*pendingTransfer, spec.Org)) {
+		res = append(res, fmt.Sprintf("org: desired %q, observed %q", spec.Org, owner))
+	}
+
+	if spec.Name != repo.Name {
+		res = append(res, fmt.Sprintf("name: desired %q, observed %q", spec.Name, repo.Name))
+	}
+
+	if spec.Visibility != nil {
+		res = diffString(res, "visibility", spec.Visibility, repo.Visibility)
+	} else {
+		res = diffBool(res, "private", spec.Private, repo.Private)
+	}
+
+	res = diffString(res, "description", spec.Description, repo.Description)
+	res = diffString(res, "homepage", spec.Homepage, repo.Homepage)
+	res = diffBool(res, "hasIssues", spec.HasIssues, repo.HasIssues)
+	res = diffBool(res, "hasWiki", spec.HasWiki, repo.HasWiki)
+	res = diffBool(res, "hasProjects", spec.HasProjects, repo.HasProjects)
+	res = diffBool(res, "hasDiscussions", spec.HasDiscussions, repo.HasDiscussions)
+	res = diffBool(res, "allowMergeCommit", spec.AllowMergeCommit, repo.AllowMergeCommit)
+	res = diffBool(res, "allowSquashMerge", spec.AllowSquashMerge, repo.AllowSquashMerge)
+	res = diffBool(res, "allowRebaseMerge", spec.AllowRebaseMerge, repo.AllowRebaseMerge)
+	res = diffString(res, "squashMergeCommitTitle", spec.SquashMergeCommitTitle, repo.SquashMergeCommitTitle)
+	res = diffString(res, "squashMergeCommitMessage", spec.SquashMergeCommitMessage, repo.SquashMergeCommitMessage)
+	res = diffBool(res, "deleteBranchOnMerge", spec.DeleteBranchOnMerge, repo.DeleteBranchOnMerge)
+	res = diffBool(res, "allowAutoMerge", spec.AllowAutoMerge, repo.AllowAutoMerge)
+	res = diffBool(res, "allowUpdateBranch", spec.AllowUpdateBranch, repo.AllowUpdateBranch)
+	res = diffBool(res, "webCommitSignoffRequired", spec.WebCommitSignoffRequired, repo.WebCommitSignoffRequired)
+	res = diffBool(res, "isTemplate", spec.IsTemplate, repo.IsTemplate)
+
+	if spec.Topics != nil && !sameTopics(spec.Topics, repo.Topics) {
+		res = append(res, fmt.Sprintf("topics: desired %v, observed %v", normalizeTopics(spec.Topics), repo.Topics))
+	}
+
+	return res
+}
+
+// sameTopics reports whether the topics are the same, ignoring order and case.
+func sameTopics(desired, observed []string) bool {
+	a, b := normalizeTopics(desired), normalizeTopics(observed)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeTopics returns the sorted, lowercase and deduplicated topics as GitHub stores them.
+func normalizeTopics(topics []string) []string {
+	res := []string{}
+	for _, t := range topics {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t != "" && !helpers.StringSliceContains(res, t) {
+			res = append(res, t)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// diffCustomProperties returns the differences between the desired
+// custom property values and the observed ones; other properties are ignored.
+func diffCustomProperties(desired, observed map[string]string) []string {
+	keys := make([]string, 0, len(desired))
+	for k := range desired {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := []string{}
+	for _, k := range keys {
+		if desired[k] != observed[k] {
+			res = append(res, fmt.Sprintf("customProperties.%s: desired %q, observed %q", k, desired[k], observed[k]))
+		}
+	}
+	return res
+}
+
+// diffMultiSelectCustomProperties returns the differences between the desired multi_select
+// custom property values and the observed ones, ignoring order; other properties are ignored.
+func diffMultiSelectCustomProperties(desired, observed map[string][]string) []string {
+	keys := make([]string, 0, len(desired))
+	for k := range desired {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := []string{}
+	for _, k := range keys {
+		if !sameValues(desired[k], observed[k]) {
+			res = append(res, fmt.Sprintf("multiSelectCustomProperties.%s: desired %q, observed %q", k, desired[k], observed[k]))
+		}
+	}
+	return res
+}
+
+// sameValues reports whether the values are the same, ignoring order.
+func sameValues(desired, observed []string) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+
+	a := append([]string{}, desired...)
+	b := append([]string{}, observed...)
+	sort.Strings(a)
+	sort.Strings(b)
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// managedCustomProperties returns the observed values of the desired custom properties.
+func managedCustomProperties(desired, observed map[string]string) map[string]string {
+	res := map[string]string{}
+	for k := range desired {
+		if v, ok := observed[k]; ok {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// managedMultiSelectCustomProperties returns the observed values of the desired multi_select custom properties.
+func managedMultiSelectCustomProperties(desired, observed map[string][]string) map[string][]string {
+	res := map[string][]string{}
+	for k := range desired {
+		if v, ok := observed[k]; ok {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// diffString appends to res the difference of an optional field, if any.
+func diffString(res []string, field string, desired *string, observed string) []string {
+	if desired == nil || *desired == observed {
+		return res
+	}
+	return append(res, fmt.Sprintf("%s: desired %q, observed %q", field, *desired, observed))
+}
+
+// diffBool appends to res the difference of an optional field, if any.
+func diffBool(res []string, field string, desired *bool, observed bool) []string {
+	if desired == nil || *desired == observed {
+		return res
+	}
+	return append(res, fmt.Sprintf("%s: desired %t, observed %t", field, *desired, observed))
+}
+
+// generateObservation maps the observed repository to the status of the managed resource.
+func generateObservation(repo *github.Repository) repov1alpha1.RepoObservation {
+	res := repov1alpha1.RepoObservation{
+		Id:              helpers.Int64Ptr(repo.ID),
+		NodeId:          helpers.StringPtr(repo.NodeID),
+		Url:             helpers.StringPtr(repo.HtmlURL),
+		CloneUrl:        helpers.StringPtr(repo.CloneURL),
+		SshUrl:          helpers.StringPtr(repo.SshURL),
+		DefaultBranch:   helpers.StringPtr(repo.DefaultBranch),
+		Private:         helpers.BoolPtr(repo.Private),
+		Visibility:      helpers.StringPtr(repo.Visibility),
+		Archived:        helpers.BoolPtr(repo.Archived),
+		Fork:            helpers.BoolPtr(repo.Fork),
+		Size:            helpers.Int64Ptr(repo.Size),
+		OpenIssuesCount: helpers.Int64Ptr(repo.OpenIssuesCount),
+		Topics:          repo.Topics,
+	}
+
+	if repo.Parent != nil {
+		res.Parent = helpers.StringPtr(repo.Parent.FullName)
+	}
+
+	if repo.Source != nil {
+		res.Source = helpers.StringPtr(repo.Source.FullName)
+	}
+
+	if repo.PushedAt != nil {
+		t := metav1.NewTime(*repo.PushedAt)
+		res.PushedAt = &t
+	}
+
+	return res
+}
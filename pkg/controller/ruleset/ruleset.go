@@ -0,0 +1,442 @@
+package ruleset
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	rulesetv1alpha1 "github.com/krateoplatformops/provider-github/apis/ruleset/v1alpha1"
+	githubv1alpha1 "github.com/krateoplatformops/provider-github/apis/v1alpha1"
+	"github.com/krateoplatformops/provider-github/pkg/clients"
+	"github.com/krateoplatformops/provider-github/pkg/clients/github"
+	"github.com/krateoplatformops/provider-github/pkg/controller/ratelimit"
+	"github.com/krateoplatformops/provider-github/pkg/helpers"
+)
+
+const (
+	errNotRuleset = "managed resource is not a ruleset custom resource"
+)
+
+// Reasons of the events recorded on state transitions.
+const (
+	reasonCreated        = "RulesetCreated"
+	reasonDriftDetected  = "RulesetDriftDetected"
+	reasonDriftCorrected = "RulesetDriftCorrected"
+	reasonDeleted        = "RulesetDeleted"
+)
+
+// Setup adds a controller that reconciles Ruleset managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(rulesetv1alpha1.RulesetGroupKind)
+
+	log := o.Logger.WithValues("controller", name)
+
+	recorder := mgr.GetEventRecorderFor(name)
+
+	rl := ratelimit.NewTracker()
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(rulesetv1alpha1.RulesetGroupVersionKind),
+		managed.WithExternalConnecter(rl.NewConnecter(&connector{
+			kube:     mgr.GetClient(),
+			log:      log,
+			recorder: recorder,
+		})),
+		// The external-name is set to the ruleset id by the
+		// external client, never to the resource name.
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(recorder)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&rulesetv1alpha1.Ruleset{}).
+		Complete(ratelimiter.NewReconciler(name, rl.NewReconciler(r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube     client.Client
+	log      logging.Logger
+	recorder record.EventRecorder
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*rulesetv1alpha1.Ruleset)
+	if !ok {
+		return nil, errors.New(errNotRuleset)
+	}
+
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	ghCli, err := github.NewClient(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:  c.kube,
+		log:   c.log,
+		ghCli: ghCli,
+		rec:   c.recorder,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube  client.Client
+	log   logging.Logger
+	ghCli *github.Client
+	rec   record.EventRecorder
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*rulesetv1alpha1.Ruleset)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRuleset)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	id, ok := rulesetID(cr)
+	if !ok {
+		e.log.Debug("Ruleset not created yet", "org", spec.Org, "name", spec.Name)
+
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	rs, err := e.ghCli.Rulesets().Get(ctx, spec.Org, helpers.StringValue(spec.Repo), id)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if rs == nil {
+		e.log.Debug("Ruleset does not exists", "org", spec.Org, "name", spec.Name, "id", id)
+
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	cr.Status.AtProvider = generateObservation(rs)
+	cr.SetConditions(xpv1.Available())
+
+	drift := diff(github.RulesetFromParams(spec), rs)
+	if len(drift) == 0 {
+		if prev := cr.GetCondition(githubv1alpha1.TypeDrift); prev.Status == corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftCorrected, "Ruleset '%s' (%d) drift corrected: %s", spec.Name, id, prev.Message)
+		}
+		cr.SetConditions(githubv1alpha1.InSync())
+	} else {
+		details := strings.Join(drift, "; ")
+		if cr.GetCondition(githubv1alpha1.TypeDrift).Status != corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftDetected, "Ruleset '%s' (%d) drift detected: %s", spec.Name, id, details)
+		}
+		cr.SetConditions(githubv1alpha1.DriftDetected(details))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(drift) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*rulesetv1alpha1.Ruleset)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRuleset)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	rs, err := e.ghCli.Rulesets().Create(ctx, spec)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, strconv.FormatInt(rs.ID, 10))
+	e.log.Debug("Ruleset created", "org", spec.Org, "name", spec.Name, "id", rs.ID)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonCreated, "Ruleset '%s' (%d) created", spec.Name, rs.ID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*rulesetv1alpha1.Ruleset)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRuleset)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	id, ok := rulesetID(cr)
+	if !ok {
+		return managed.ExternalUpdate{}, fmt.Errorf("invalid ruleset id %q", meta.GetExternalName(cr))
+	}
+
+	err := e.ghCli.Rulesets().Update(ctx, id, spec)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	e.log.Debug("Ruleset updated", "org", spec.Org, "name", spec.Name, "id", id)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*rulesetv1alpha1.Ruleset)
+	if !ok {
+		return errors.New(errNotRuleset)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	id, ok := rulesetID(cr)
+	if !ok {
+		return nil
+	}
+
+	if err := e.ghCli.Rulesets().Delete(ctx, spec.Org, helpers.StringValue(spec.Repo), id); err != nil {
+		return err
+	}
+	e.log.Debug("Ruleset deleted", "org", spec.Org, "name", spec.Name, "id", id)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDeleted, "Ruleset '%s' (%d) deleted", spec.Name, id)
+
+	return nil
+}
+
+// rulesetID returns the ruleset id stored in the external-name annotation.
+func rulesetID(cr *rulesetv1alpha1.Ruleset) (int64, bool) {
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
+
+// diff returns the differences between the desired ruleset and the observed one.
+func diff(desired, observed *github.Ruleset) []string {
+	res := []string{}
+
+	res = diffString(res, "name", desired.Name, observed.Name)
+	res = diffString(res, "target", desired.Target, observed.Target)
+	res = diffString(res, "enforcement", desired.Enforcement, observed.Enforcement)
+
+	if a, b := bypassActors(desired.BypassActors), bypassActors(observed.BypassActors); !sameStrings(a, b) {
+		res = append(res, fmt.Sprintf("bypassActors: desired %v, observed %v", a, b))
+	}
+
+	want, got := &github.RulesetConditions{}, &github.RulesetConditions{}
+	if desired.Conditions != nil {
+		want = desired.Conditions
+	}
+	if observed.Conditions != nil {
+		got = observed.Conditions
+	}
+	res = diffPatterns(res, "conditions.refName", want.RefName, got.RefName)
+	res = diffPatterns(res, "conditions.repositoryName", want.RepositoryName, got.RepositoryName)
+
+	rules := map[string]*github.RulesetRuleParameters{}
+	for _, r := range observed.Rules {
+		rules[r.Type] = r.Parameters
+	}
+
+	for _, r := range desired.Rules {
+		params, ok := rules[r.Type]
+		if !ok {
+			res = append(res, fmt.Sprintf("rules.%s: desired enabled, observed disabled", r.Type))
+			continue
+		}
+		delete(rules, r.Type)
+
+		a, b := normalizeParameters(r.Parameters), normalizeParameters(params)
+		if !reflect.DeepEqual(a, b) {
+			res = append(res, fmt.Sprintf("rules.%s: desired %v, observed %v", r.Type, a, b))
+		}
+	}
+
+	types := make([]string, 0, len(rules))
+	for t := range rules {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	for _, t := range types {
+		res = append(res, fmt.Sprintf("rules.%s: desired disabled, observed enabled", t))
+	}
+
+	return res
+}
+
+// diffString appends to res the difference of a field, if any.
+func diffString(res []string, field string, desired, observed string) []string {
+	if desired == observed {
+		return res
+	}
+	return append(res, fmt.Sprintf("%s: desired %q, observed %q", field, desired, observed))
+}
+
+// diffPatterns appends to res the difference of a condition, if any, ignoring order.
+func diffPatterns(res []string, field string, desired, observed *github.RulesetPatterns) []string {
+	if desired == nil {
+		desired = &github.RulesetPatterns{}
+	}
+	if observed == nil {
+		observed = &github.RulesetPatterns{}
+	}
+
+	if !sameStrings(desired.Include, observed.Include) {
+		res = append(res, fmt.Sprintf("%s.include: desired %v, observed %v", field, desired.Include, observed.Include))
+	}
+	if !sameStrings(desired.Exclude, observed.Exclude) {
+		res = append(res, fmt.Sprintf("%s.exclude: desired %v, observed %v", field, desired.Exclude, observed.Exclude))
+	}
+	return res
+}
+
+// bypassActors returns the sorted actors as type/id/mode; the
+// id of the organization admins is assigned by GitHub.
+func bypassActors(actors []github.RulesetBypassActor) []string {
+	res := make([]string, 0, len(actors))
+	for _, a := range actors {
+		id := ""
+		if a.ActorID != nil && a.ActorType != "OrganizationAdmin" {
+			id = strconv.FormatInt(*a.ActorID, 10)
+		}
+		res = append(res, fmt.Sprintf("%s/%s/%s", a.ActorType, id, a.BypassMode))
+	}
+	sort.Strings(res)
+	return res
+}
+
+// sameStrings reports whether the lists hold the same values, ignoring order.
+func sameStrings(desired, observed []string) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+
+	a := append([]string{}, desired...)
+	b := append([]string{}, observed...)
+	sort.Strings(a)
+	sort.Strings(b)
+
+	return reflect.DeepEqual(a, b)
+}
+
+// normalizeParameters returns the parameters of a rule as a generic value without
+// the empty fields, so that unset and zero values compare equal, and with the lists
+// sorted, since GitHub does not preserve their order.
+func normalizeParameters(params *github.RulesetRuleParameters) interface{} {
+	if params == nil {
+		return map[string]interface{}{}
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil
+	}
+
+	var res interface{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil
+	}
+
+	return prune(res)
+}
+
+// prune removes the zero values from the JSON value and sorts the lists.
+func prune(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		res := map[string]interface{}{}
+		for k, el := range val {
+			if el = prune(el); !isZero(el) {
+				res[k] = el
+			}
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, 0, len(val))
+		for _, el := range val {
+			res = append(res, prune(el))
+		}
+		sort.SliceStable(res, func(i, j int) bool {
+			return sortKey(res[i]) < sortKey(res[j])
+		})
+		return res
+	}
+	return v
+}
+
+// sortKey returns the JSON encoding of the value, used to sort the lists.
+func sortKey(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// isZero reports whether the JSON value is null, false, zero, empty or an empty list.
+func isZero(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !val
+	case float64:
+		return val == 0
+	case string:
+		return val == ""
+	case []interface{}:
+		return len(val) == 0
+	}
+	return false
+}
+
+// generateObservation maps the observed ruleset to the status of the managed resource.
+func generateObservation(rs *github.Ruleset) rulesetv1alpha1.RulesetObservation {
+	res := rulesetv1alpha1.RulesetObservation{
+		Id:          helpers.Int64Ptr(rs.ID),
+		NodeId:      helpers.StringPtr(rs.NodeID),
+		SourceType:  helpers.StringPtr(rs.SourceType),
+		Enforcement: helpers.StringPtr(rs.Enforcement),
+	}
+
+	if rs.Links != nil {
+		res.Url = helpers.StringPtr(rs.Links.HTML.Href)
+	}
+
+	return res
+}
@@ -0,0 +1,543 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	orgv1alpha1 "github.com/krateoplatformops/provider-github/apis/org/v1alpha1"
+	githubv1alpha1 "github.com/krateoplatformops/provider-github/apis/v1alpha1"
+	"github.com/krateoplatformops/provider-github/pkg/clients"
+	"github.com/krateoplatformops/provider-github/pkg/clients/github"
+	"github.com/krateoplatformops/provider-github/pkg/controller/ratelimit"
+	"github.com/krateoplatformops/provider-github/pkg/helpers"
+)
+
+const (
+	errNotTeam = "managed resource is not a team custom resource"
+)
+
+// Reasons of the events recorded on state transitions.
+const (
+	reasonCreated        = "TeamCreated"
+	reasonDriftDetected  = "TeamDriftDetected"
+	reasonDriftCorrected = "TeamDriftCorrected"
+	reasonDeleted        = "TeamDeleted"
+)
+
+// Roles of the team members.
+const (
+	roleMaintainer = "maintainer"
+	roleMember     = "member"
+)
+
+// Setup adds a controller that reconciles Team managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(orgv1alpha1.TeamGroupKind)
+
+	log := o.Logger.WithValues("controller", name)
+
+	recorder := mgr.GetEventRecorderFor(name)
+
+	rl := ratelimit.NewTracker()
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(orgv1alpha1.TeamGroupVersionKind),
+		managed.WithExternalConnecter(rl.NewConnecter(&connector{
+			kube:     mgr.GetClient(),
+			log:      log,
+			recorder: recorder,
+		})),
+		// The external-name is set to the team slug by the
+		// external client, never to the resource name.
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(recorder)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&orgv1alpha1.Team{}).
+		Complete(ratelimiter.NewReconciler(name, rl.NewReconciler(r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube     client.Client
+	log      logging.Logger
+	recorder record.EventRecorder
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*orgv1alpha1.Team)
+	if !ok {
+		return nil, errors.New(errNotTeam)
+	}
+
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	ghCli, err := github.NewClient(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:  c.kube,
+		log:   c.log,
+		ghCli: ghCli,
+		rec:   c.recorder,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube  client.Client
+	log   logging.Logger
+	ghCli *github.Client
+	rec   record.EventRecorder
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*orgv1alpha1.Team)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTeam)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	slug := meta.GetExternalName(cr)
+	if slug == "" {
+		e.log.Debug("Team not created yet", "org", spec.Org, "name", spec.Name)
+
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	team, err := e.ghCli.Teams().Get(ctx, spec.Org, slug)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// The team may have been renamed by the last update, so
+	// look it up by the slug observed after the update.
+	if observed := helpers.StringValue(cr.Status.AtProvider.Slug); team == nil && observed != "" && observed != slug {
+		team, err = e.ghCli.Teams().Get(ctx, spec.Org, observed)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	// The team may have been renamed outside of the resource, so
+	// look it up by the identifier observed the last time.
+	if team == nil && cr.Status.AtProvider.Id != nil {
+		team, err = e.getByID(ctx, spec.Org, *cr.Status.AtProvider.Id)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	if team == nil {
+		e.log.Debug("Team does not exists", "org", spec.Org, "slug", slug)
+
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	// Track the slug, which changes when the team is renamed.
+	lateInitialized := false
+	if team.Slug != slug {
+		meta.SetExternalName(cr, team.Slug)
+		slug = team.Slug
+		lateInitialized = true
+	}
+
+	cr.Status.AtProvider = generateObservation(team)
+
+	// The membership is only observed when managed by the resource.
+	if managesMembership(spec) {
+		maintainers, err := e.ghCli.Teams().ListMembers(ctx, spec.Org, slug, roleMaintainer)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		members, err := e.ghCli.Teams().ListMembers(ctx, spec.Org, slug, roleMember)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		inherited, err := e.inheritedMembers(ctx, spec.Org, slug)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		want := desiredRoles(spec)
+		cr.Status.AtProvider.Maintainers = excludeInherited(maintainers, inherited, want)
+		cr.Status.AtProvider.Members = excludeInherited(members, inherited, want)
+
+		if err := e.observeInvitations(ctx, spec, slug, &cr.Status.AtProvider); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	drift := diff(spec, team, &cr.Status.AtProvider)
+	if len(drift) == 0 {
+		if prev := cr.GetCondition(githubv1alpha1.TypeDrift); prev.Status == corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftCorrected, "Team '%s/%s' drift corrected: %s", spec.Org, slug, prev.Message)
+		}
+		cr.SetConditions(githubv1alpha1.InSync())
+	} else {
+		details := strings.Join(drift, "; ")
+		if cr.GetCondition(githubv1alpha1.TypeDrift).Status != corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftDetected, "Team '%s/%s' drift detected: %s", spec.Org, slug, details)
+		}
+		cr.SetConditions(githubv1alpha1.DriftDetected(details))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        len(drift) == 0,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*orgv1alpha1.Team)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTeam)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+
+	parentID, err := e.parentTeamID(ctx, spec, nil)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	team, err := e.ghCli.Teams().Create(ctx, spec, parentID)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, team.Slug)
+	e.log.Debug("Team created", "org", spec.Org, "slug", team.Slug)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonCreated, "Team '%s/%s' created", spec.Org, team.Slug)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*orgv1alpha1.Team)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTeam)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+	slug := meta.GetExternalName(cr)
+
+	parentID, err := e.parentTeamID(ctx, spec, cr.Status.AtProvider.ParentTeamSlug)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	team, err := e.ghCli.Teams().Update(ctx, slug, spec, parentID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Renaming the team changes its slug; the external-name
+	// is updated by the next observation.
+	cr.Status.AtProvider.Slug = helpers.StringPtr(team.Slug)
+	slug = team.Slug
+
+	if managesMembership(spec) {
+		if err := e.syncMembership(ctx, spec, slug, &cr.Status.AtProvider); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	e.log.Debug("Team updated", "org", spec.Org, "slug", slug)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*orgv1alpha1.Team)
+	if !ok {
+		return errors.New(errNotTeam)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+	slug := meta.GetExternalName(cr)
+
+	if err := e.ghCli.Teams().Delete(ctx, spec.Org, slug); err != nil {
+		return err
+	}
+	e.log.Debug("Team deleted", "org", spec.Org, "slug", slug)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDeleted, "Team '%s/%s' deleted", spec.Org, slug)
+
+	return nil
+}
+
+// managesMembership reports whether the team members are managed by the resource.
+func managesMembership(spec *orgv1alpha1.TeamParams) bool {
+	return spec.Maintainers != nil || spec.Members != nil
+}
+
+// parentTeamID returns the id of the desired parent team: zero leaves the
+// parent untouched, a negative value removes the observed parent.
+func (e *external) parentTeamID(ctx context.Context, spec *orgv1alpha1.TeamParams, observed *string) (int64, error) {
+	if spec.ParentTeamSlug == nil {
+		return 0, nil
+	}
+
+	if *spec.ParentTeamSlug == "" {
+		if helpers.StringValue(observed) != "" {
+			return -1, nil
+		}
+		return 0, nil
+	}
+
+	parent, err := e.ghCli.Teams().Get(ctx, spec.Org, *spec.ParentTeamSlug)
+	if err != nil {
+		return 0, err
+	}
+	if parent == nil {
+		return 0, fmt.Errorf("parent team '%s/%s' not found", spec.Org, *spec.ParentTeamSlug)
+	}
+
+	return parent.ID, nil
+}
+
+// getByID fetches the team by id, which does not change when the team is renamed.
+func (e *external) getByID(ctx context.Context, org string, id int64) (*github.Team, error) {
+	o, err := e.ghCli.Orgs().Get(ctx, org)
+	if err != nil || o == nil {
+		return nil, err
+	}
+
+	return e.ghCli.Teams().GetByID(ctx, o.ID, id)
+}
+
+// inheritedMembers returns the lowercase logins of the members of the child teams,
+// which GitHub lists as members of the team as well.
+func (e *external) inheritedMembers(ctx context.Context, org, slug string) (map[string]bool, error) {
+	children, err := e.ghCli.Teams().ListChildTeams(ctx, org, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]bool{}
+	for _, child := range children {
+		// The members of a child team include those of its own child teams.
+		users, err := e.ghCli.Teams().ListMembers(ctx, org, child, "all")
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			res[strings.ToLower(u)] = true
+		}
+	}
+
+	return res, nil
+}
+
+// excludeInherited removes from users those not desired who are members of a child
+// team, since GitHub cannot tell whether they are direct members of the team too.
+func excludeInherited(users []string, inherited map[string]bool, want map[string]string) []string {
+	res := make([]string, 0, len(users))
+	for _, u := range users {
+		if _, ok := want[strings.ToLower(u)]; !ok && inherited[strings.ToLower(u)] {
+			continue
+		}
+		res = append(res, u)
+	}
+	return res
+}
+
+// observeInvitations adds to the observed membership the desired users
+// invited to the team who have not accepted yet, so they are not invited again.
+func (e *external) observeInvitations(ctx context.Context, spec *orgv1alpha1.TeamParams, slug string, observed *orgv1alpha1.TeamObservation) error {
+	want := desiredRoles(spec)
+	got := observedRoles(observed)
+
+	users := make([]string, 0, len(want))
+	for user := range want {
+		if _, ok := got[user]; !ok {
+			users = append(users, user)
+		}
+	}
+	sort.Strings(users)
+
+	for _, user := range users {
+		m, err := e.ghCli.Teams().GetMembership(ctx, spec.Org, slug, user)
+		if err != nil {
+			return err
+		}
+		if m == nil || m.State != "pending" {
+			continue
+		}
+
+		if m.Role == roleMaintainer {
+			observed.Maintainers = append(observed.Maintainers, user)
+		} else {
+			observed.Members = append(observed.Members, user)
+		}
+		observed.Invited = append(observed.Invited, user)
+	}
+
+	return nil
+}
+
+// syncMembership adds the missing users, changes the roles and removes the users not desired.
+func (e *external) syncMembership(ctx context.Context, spec *orgv1alpha1.TeamParams, slug string, observed *orgv1alpha1.TeamObservation) error {
+	want := desiredRoles(spec)
+	got := observedRoles(observed)
+
+	users := make([]string, 0, len(want))
+	for user := range want {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+
+	for _, user := range users {
+		if got[user] == want[user] {
+			continue
+		}
+		if err := e.ghCli.Teams().SetMembership(ctx, spec.Org, slug, user, want[user]); err != nil {
+			return err
+		}
+		e.log.Debug("Team membership set", "org", spec.Org, "slug", slug, "user", user, "role", want[user])
+	}
+
+	for user := range got {
+		if _, ok := want[user]; ok {
+			continue
+		}
+		if err := e.ghCli.Teams().RemoveMembership(ctx, spec.Org, slug, user); err != nil {
+			return err
+		}
+		e.log.Debug("Team membership removed", "org", spec.Org, "slug", slug, "user", user)
+	}
+
+	return nil
+}
+
+// desiredRoles returns the desired role by lowercase login; maintainers take precedence.
+func desiredRoles(spec *orgv1alpha1.TeamParams) map[string]string {
+	res := map[string]string{}
+	for _, u := range spec.Members {
+		res[strings.ToLower(u)] = roleMember
+	}
+	for _, u := range spec.Maintainers {
+		res[strings.ToLower(u)] = roleMaintainer
+	}
+	return res
+}
+
+// observedRoles returns the observed role by lowercase login.
+func observedRoles(observed *orgv1alpha1.TeamObservation) map[string]string {
+	res := map[string]string{}
+	for _, u := range observed.Members {
+		res[strings.ToLower(u)] = roleMember
+	}
+	for _, u := range observed.Maintainers {
+		res[strings.ToLower(u)] = roleMaintainer
+	}
+	return res
+}
+
+// diff returns the differences between the desired state and the observed team.
+func diff(spec *orgv1alpha1.TeamParams, team *github.Team, observed *orgv1alpha1.TeamObservation) []string {
+	res := []string{}
+
+	if spec.Name != team.Name {
+		res = append(res, fmt.Sprintf("name: desired %q, observed %q", spec.Name, team.Name))
+	}
+
+	res = diffString(res, "description", spec.Description, team.Description)
+	res = diffString(res, "privacy", spec.Privacy, team.Privacy)
+	res = diffString(res, "notificationSetting", spec.NotificationSetting, team.NotificationSetting)
+	res = diffString(res, "parentTeamSlug", spec.ParentTeamSlug, helpers.StringValue(observed.ParentTeamSlug))
+
+	if managesMembership(spec) {
+		want, got := desiredRoles(spec), observedRoles(observed)
+
+		users := []string{}
+		for user, role := range want {
+			if got[user] != role {
+				users = append(users, fmt.Sprintf("%s (desired %s, observed %s)", user, role, roleOrNone(got[user])))
+			}
+		}
+		for user, role := range got {
+			if _, ok := want[user]; !ok {
+				users = append(users, fmt.Sprintf("%s (desired none, observed %s)", user, role))
+			}
+		}
+		sort.Strings(users)
+
+		if len(users) > 0 {
+			res = append(res, fmt.Sprintf("membership: %s", strings.Join(users, ", ")))
+		}
+	}
+
+	return res
+}
+
+func roleOrNone(role string) string {
+	if role == "" {
+		return "none"
+	}
+	return role
+}
+
+// diffString appends to res the difference of an optional field, if any.
+func diffString(res []string, field string, desired *string, observed string) []string {
+	if desired == nil || *desired == observed {
+		return res
+	}
+	return append(res, fmt.Sprintf("%s: desired %q, observed %q", field, *desired, observed))
+}
+
+// generateObservation maps the observed team to the status of the managed resource.
+func generateObservation(team *github.Team) orgv1alpha1.TeamObservation {
+	res := orgv1alpha1.TeamObservation{
+		Id:      helpers.Int64Ptr(team.ID),
+		NodeId:  helpers.StringPtr(team.NodeID),
+		Slug:    helpers.StringPtr(team.Slug),
+		Url:     helpers.StringPtr(team.HtmlURL),
+		Privacy: helpers.StringPtr(team.Privacy),
+	}
+
+	if team.Parent != nil {
+		res.ParentTeamSlug = helpers.StringPtr(team.Parent.Slug)
+	}
+
+	return res
+}
@@ -0,0 +1,294 @@
+package teamrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	orgv1alpha1 "github.com/krateoplatformops/provider-github/apis/org/v1alpha1"
+	githubv1alpha1 "github.com/krateoplatformops/provider-github/apis/v1alpha1"
+	"github.com/krateoplatformops/provider-github/pkg/clients"
+	"github.com/krateoplatformops/provider-github/pkg/clients/github"
+	"github.com/krateoplatformops/provider-github/pkg/controller/ratelimit"
+	"github.com/krateoplatformops/provider-github/pkg/helpers"
+)
+
+const (
+	errNotTeamRepository = "managed resource is not a team repository custom resource"
+)
+
+const (
+	// annotationGranted marks the resources that granted the access to the
+	// team, since GitHub reports the access inherited from a parent team too.
+	annotationGranted = "github.krateo.io/granted"
+)
+
+// Reasons of the events recorded on state transitions.
+const (
+	reasonCreated        = "TeamRepositoryGranted"
+	reasonDriftDetected  = "TeamRepositoryDriftDetected"
+	reasonDriftCorrected = "TeamRepositoryDriftCorrected"
+	reasonDeleted        = "TeamRepositoryRevoked"
+)
+
+// Setup adds a controller that reconciles TeamRepository managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(orgv1alpha1.TeamRepositoryGroupKind)
+
+	log := o.Logger.WithValues("controller", name)
+
+	recorder := mgr.GetEventRecorderFor(name)
+
+	rl := ratelimit.NewTracker()
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(orgv1alpha1.TeamRepositoryGroupVersionKind),
+		managed.WithExternalConnecter(rl.NewConnecter(&connector{
+			kube:     mgr.GetClient(),
+			log:      log,
+			recorder: recorder,
+		})),
+		// The external-name is set to org/team/repo by the
+		// external client, never to the resource name.
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(log),
+		managed.WithRecorder(event.NewAPIRecorder(recorder)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&orgv1alpha1.TeamRepository{}).
+		Complete(ratelimiter.NewReconciler(name, rl.NewReconciler(r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube     client.Client
+	log      logging.Logger
+	recorder record.EventRecorder
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*orgv1alpha1.TeamRepository)
+	if !ok {
+		return nil, errors.New(errNotTeamRepository)
+	}
+
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	ghCli, err := github.NewClient(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		kube:  c.kube,
+		log:   c.log,
+		ghCli: ghCli,
+		rec:   c.recorder,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube  client.Client
+	log   logging.Logger
+	ghCli *github.Client
+	rec   record.EventRecorder
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*orgv1alpha1.TeamRepository)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTeamRepository)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+	if err := validate(spec); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	slug := *spec.TeamSlug
+	owner, repo := repoFullName(spec)
+
+	role, err := e.ghCli.Teams().GetRepoPermission(ctx, spec.Org, slug, owner, repo)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// The access inherited from a parent team, or granted before the resource
+	// existed, is granted again to the team directly so that it is owned.
+	if role != "" && cr.GetAnnotations()[annotationGranted] != "true" {
+		e.log.Debug("Team access to the repo not granted by the resource", "org", spec.Org, "team", slug, "repo", repo, "permission", role)
+		role = ""
+	}
+
+	if role == "" {
+		e.log.Debug("Team has no access to the repo", "org", spec.Org, "team", slug, "repo", repo)
+
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	lateInitialized := false
+	if en := externalName(spec); meta.GetExternalName(cr) != en {
+		meta.SetExternalName(cr, en)
+		lateInitialized = true
+	}
+
+	cr.Status.AtProvider.Permission = helpers.StringPtr(role)
+	cr.SetConditions(xpv1.Available())
+
+	drift := []string{}
+	if want := roleName(spec.Permission); !strings.EqualFold(want, role) {
+		drift = append(drift, fmt.Sprintf("permission: desired %q, observed %q", want, role))
+	}
+
+	if len(drift) == 0 {
+		if prev := cr.GetCondition(githubv1alpha1.TypeDrift); prev.Status == corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftCorrected, "Team repository '%s' drift corrected: %s", externalName(spec), prev.Message)
+		}
+		cr.SetConditions(githubv1alpha1.InSync())
+	} else {
+		details := strings.Join(drift, "; ")
+		if cr.GetCondition(githubv1alpha1.TypeDrift).Status != corev1.ConditionTrue {
+			e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDriftDetected, "Team repository '%s' drift detected: %s", externalName(spec), details)
+		}
+		cr.SetConditions(githubv1alpha1.DriftDetected(details))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        len(drift) == 0,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*orgv1alpha1.TeamRepository)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTeamRepository)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+	if err := validate(spec); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	owner, repo := repoFullName(spec)
+	err := e.ghCli.Teams().SetRepoPermission(ctx, spec.Org, *spec.TeamSlug, owner, repo, spec.Permission)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, externalName(spec))
+	meta.AddAnnotations(cr, map[string]string{annotationGranted: "true"})
+	e.log.Debug("Team repository access granted", "org", spec.Org, "team", *spec.TeamSlug, "repo", *spec.Repo, "permission", spec.Permission)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonCreated, "Team repository '%s' access granted: %s", externalName(spec), spec.Permission)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*orgv1alpha1.TeamRepository)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTeamRepository)
+	}
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+	if err := validate(spec); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	owner, repo := repoFullName(spec)
+	err := e.ghCli.Teams().SetRepoPermission(ctx, spec.Org, *spec.TeamSlug, owner, repo, spec.Permission)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	e.log.Debug("Team repository updated", "org", spec.Org, "team", *spec.TeamSlug, "repo", *spec.Repo, "permission", spec.Permission)
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*orgv1alpha1.TeamRepository)
+	if !ok {
+		return errors.New(errNotTeamRepository)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	spec := cr.Spec.ForProvider.DeepCopy()
+	if err := validate(spec); err != nil {
+		return err
+	}
+
+	owner, repo := repoFullName(spec)
+	if err := e.ghCli.Teams().RemoveRepo(ctx, spec.Org, *spec.TeamSlug, owner, repo); err != nil {
+		return err
+	}
+	e.log.Debug("Team repository access revoked", "org", spec.Org, "team", *spec.TeamSlug, "repo", *spec.Repo)
+	e.rec.Eventf(cr, corev1.EventTypeNormal, reasonDeleted, "Team repository '%s' access revoked", externalName(spec))
+
+	return nil
+}
+
+// validate checks that the team and the repository have been set or resolved.
+func validate(spec *orgv1alpha1.TeamRepositoryParams) error {
+	if helpers.StringValue(spec.TeamSlug) == "" {
+		return errors.New("teamSlug, teamSlugRef or teamSlugSelector is required")
+	}
+	if helpers.StringValue(spec.Repo) == "" {
+		return errors.New("repo, repoRef or repoSelector is required")
+	}
+	return nil
+}
+
+// repoFullName returns the owner and the name of the repository;
+// the owner defaults to the organization of the team.
+func repoFullName(spec *orgv1alpha1.TeamRepositoryParams) (string, string) {
+	repo := helpers.StringValue(spec.Repo)
+	if i := strings.Index(repo, "/"); i >= 0 {
+		return repo[:i], repo[i+1:]
+	}
+	return spec.Org, repo
+}
+
+// externalName returns the external-name of the binding (org/team/repo or org/team/owner/repo).
+func externalName(spec *orgv1alpha1.TeamRepositoryParams) string {
+	return fmt.Sprintf("%s/%s/%s", spec.Org, helpers.StringValue(spec.TeamSlug), helpers.StringValue(spec.Repo))
+}
+
+// roleName returns the role name GitHub reports for the permission;
+// pull and push are reported as read and write.
+func roleName(permission string) string {
+	switch permission {
+	case "pull":
+		return "read"
+	case "push":
+		return "write"
+	}
+	return permission
+}
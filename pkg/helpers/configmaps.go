@@ -0,0 +1,28 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetConfigMapValue returns the value of a ConfigMap key, looking up binary data too.
+func GetConfigMapValue(ctx context.Context, k client.Client, namespace, name, key string) ([]byte, error) {
+	cm := &corev1.ConfigMap{}
+	if err := k.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cm); err != nil {
+		return nil, errors.Wrapf(err, "cannot get %s configmap", name)
+	}
+
+	if v, ok := cm.Data[key]; ok {
+		return []byte(v), nil
+	}
+
+	if v, ok := cm.BinaryData[key]; ok {
+		return v, nil
+	}
+
+	return nil, errors.Errorf("key %s not found in %s configmap", key, name)
+}
@@ -61,13 +61,14 @@ func BoolValue(v *bool) bool {
 	return *v
 }
 
-// BoolValueOrDefault sets eventually a default value for bool type.
+// BoolValueOrDefault converts the supplied bool pointer to a bool,
+// returning def if the pointer is nil.
 func BoolValueOrDefault(v *bool, def bool) bool {
 	if v == nil {
-		return false
+		return def
 	}
 
-	return def
+	return *v
 }
 
 // StringPtr converts the supplied string to a pointer to that string.